@@ -1,172 +1,20 @@
 package main
 
 import (
-	"container/list"
 	"fmt"
-	"sync"
 	"time"
-)
-
-type CacheItem struct {
-	key        string
-	value      any
-	expiration time.Time
-	element    *list.Element
-}
-
-type Cache struct {
-	capacity    int
-	items       map[string]any
-	list        *list.List
-	mu          *sync.RWMutex
-	stopCleaner chan struct{}
-}
-
-type CacheOption func(*Cache)
-
-func WithCapacity(capacity int) CacheOption {
-	return func(c *Cache) {
-		c.capacity = capacity
-		c.list = list.New()
-	}
-}
-
-func NewCache(options ...CacheOption) *Cache {
-	c := &Cache{items: make(map[string]any), mu: &sync.RWMutex{}}
-	for _, option := range options {
-		option(c)
-	}
-	if c.capacity == 0 { // TTL кеш
-		c.stopCleaner = make(chan struct{})
-		go c.cleanupLoop()
-	}
-	return c
-}
-
-func (c *Cache) cleanupLoop() {
-	ticker := time.NewTicker(time.Minute)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			c.cleanup()
-		case <-c.stopCleaner:
-			return
-		}
-	}
-}
-
-// TODO: переделать блокировки. Сначала все читаем через RLock. Ищем у кого закончилось время. Элементы добавляем
-// TODO: в отдельный слайс. После этого отдельно проходимся по этому слайсу, удаляем все элементы, используя Lock.
-func (c *Cache) cleanup() {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	now := time.Now()
-	for key, val := range c.items {
-		item := val.(*CacheItem)
-		if now.After(item.expiration) {
-			delete(c.items, key)
-		}
-	}
-}
-
-func (c *Cache) Close() {
-	if c.stopCleaner != nil {
-		close(c.stopCleaner)
-	}
-}
-
-func (c *Cache) muLoad(key string) (any, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	val, ok := c.items[key]
-	if !ok {
-		return nil, ok
-	}
-
-	return val, ok
-}
 
-func (c *Cache) muMoveToFront(item *CacheItem) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.list.MoveToFront(item.element)
-}
-
-func (c *Cache) Get(key string) (any, bool) {
-	val, ok := c.muLoad(key)
-	if !ok {
-		return nil, ok
-	}
-	item := val.(*CacheItem)
-	if time.Now().After(item.expiration) && !c.isLRU() {
-		c.Delete(item)
-		return nil, false
-	}
-
-	if c.isLRU() {
-		c.muMoveToFront(item)
-	}
-
-	return item.value, ok
-}
-
-func (c *Cache) isLRU() bool {
-	if c.list != nil {
-		return true
-	}
-
-	return false
-}
-
-func (c *Cache) Set(key string, value any, ttl time.Duration) {
-	expiration := time.Now().Add(ttl)
-	newItem := &CacheItem{key: key, value: value, expiration: expiration}
-
-	if c.isLRU() {
-		if val, ok := c.muLoad(key); ok {
-			item := val.(*CacheItem)
-			c.mu.Lock()
-			item.expiration = expiration
-			item.value = value
-			c.mu.Unlock()
-			return
-		}
-
-		if c.capacity == c.list.Len() {
-			c.mu.RLock()
-			oldElement := c.list.Back()
-			oldItem := oldElement.Value.(*CacheItem)
-			c.mu.RUnlock()
-			if oldItem != nil {
-				c.Delete(oldItem)
-			}
-		}
-		c.mu.Lock()
-		newElement := c.list.PushFront(newItem)
-		newItem.element = newElement
-		c.mu.Unlock()
-	}
-	c.mu.Lock()
-	c.items[key] = newItem
-	c.mu.Unlock()
-}
-
-func (c *Cache) Delete(item *CacheItem) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	delete(c.items, item.key)
-	if c.isLRU() {
-		c.list.Remove(item.element)
-	}
-}
+	"2less/collections/cache"
+)
 
 func main() {
 	//LRU кэш
-	lruCache := NewCache(WithCapacity(2))
+	lruCache := cache.NewCache(
+		cache.WithCapacity[string, string](2),
+		cache.WithOnEvict(func(key string, value string, reason cache.EvictReason) {
+			fmt.Printf("LRU Cache - Key: %s evicted (%s)\n", key, reason)
+		}),
+	)
 
 	lruCache.Set("key1", "value1", 0) // TTL не используется для LRU
 	lruCache.Set("key2", "value2", 0)
@@ -184,8 +32,22 @@ func main() {
 		fmt.Printf("LRU Cache - Key: key2, Value: %v\n", valueLru2)
 	}
 
+	// LRU + TTL кеш
+	lruTTLCache := cache.NewCache(cache.WithCapacity[string, string](2), cache.WithExpiration[string, string]())
+	defer lruTTLCache.Close()
+
+	lruTTLCache.Set("key1", "value1", time.Millisecond)
+	lruTTLCache.Set("key2", "value2", 0) // без ограничения по времени
+	time.Sleep(2 * time.Millisecond)
+	if _, ok := lruTTLCache.Get("key1"); !ok {
+		fmt.Println("LRU+TTL Cache - Key: key1 expired")
+	}
+	if value, ok := lruTTLCache.Get("key2"); ok {
+		fmt.Printf("LRU+TTL Cache - Key: key2, Value: %v\n", value)
+	}
+
 	// TTL кеш (без ограничения емкости)
-	ttlCache := NewCache()
+	ttlCache := cache.NewCache[string, string]()
 	defer ttlCache.Close()
 
 	ttlCache.Set("key1", "value1", 3*time.Second)
@@ -202,4 +64,9 @@ func main() {
 	//	fmt.Printf("TTL Cache - Key: key1, Value: %v\n", value2)
 	//}
 	//fmt.Println(ttlCache)
+
+	ttlCache.Delete("key2")
+	if _, ok := ttlCache.Get("key2"); !ok {
+		fmt.Println("TTL Cache - Key: key2 deleted")
+	}
 }
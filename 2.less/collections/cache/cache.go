@@ -0,0 +1,357 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type CacheItem[K comparable, V any] struct {
+	key        K
+	value      V
+	expiration time.Time
+	expIndex   int // индекс в c.expQueue, -1 если элемент не ждет истечения TTL
+	cost       int64
+
+	// состояние политики вытеснения
+	element    *list.Element // LRU, TinyLFU
+	segment    int           // TinyLFU
+	heapIndex  int           // LFU
+	freq       uint64        // LFU
+	lastAccess uint64        // LFU
+}
+
+// expired: элементы с нулевым expiration (ttl <= 0) живут бессрочно
+func (i *CacheItem[K, V]) expired(now time.Time) bool {
+	return !i.expiration.IsZero() && now.After(i.expiration)
+}
+
+type EvictReason int
+
+const (
+	EvictCapacity EvictReason = iota // вытеснен при достижении емкости
+	EvictExpired                     // истек TTL
+	EvictDeleted                     // удален через Delete
+	EvictCleared                     // удален через Clear
+	EvictReplaced                    // значение перезаписано через Set
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case EvictCapacity:
+		return "capacity"
+	case EvictExpired:
+		return "expired"
+	case EvictDeleted:
+		return "deleted"
+	case EvictCleared:
+		return "cleared"
+	case EvictReplaced:
+		return "replaced"
+	default:
+		return "unknown"
+	}
+}
+
+type eviction[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictReason
+}
+
+type Cache[K comparable, V any] struct {
+	capacity    int
+	maxCost     int64
+	totalCost   int64
+	costFunc    func(key K, value V) int64
+	items       map[K]*CacheItem[K, V]
+	policyKind  Policy
+	policy      evictionPolicy[K, V]
+	withTTL     bool
+	expQueue    expirationQueue[K, V]
+	cleanupTick time.Duration
+	onEvict     func(key K, value V, reason EvictReason)
+	evicted     []eviction[K, V]
+	loadTTL     time.Duration
+	negativeTTL time.Duration
+	loadErrors  map[K]*loadError[K]
+	loadErrExp  loadErrorQueue[K]
+	calls       map[K]*loadCall[V]
+	loadMu      *sync.Mutex
+	mu          *sync.RWMutex
+	stopCleaner chan struct{}
+
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	evictions   atomic.Uint64
+	expirations atomic.Uint64
+}
+
+type CacheOption[K comparable, V any] func(*Cache[K, V])
+
+func WithCapacity[K comparable, V any](capacity int) CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		c.capacity = capacity
+	}
+}
+
+// WithExpiration включает учет TTL для кеша с ограниченной емкостью (LRU + TTL)
+func WithExpiration[K comparable, V any]() CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		c.withTTL = true
+	}
+}
+
+// WithOnEvict задает колбэк, который вызывается для каждого удаленного из кеша элемента,
+// а также для старого значения при перезаписи ключа (EvictReplaced).
+// Колбэк вызывается после снятия блокировки, поэтому внутри него можно обращаться к кешу
+func WithOnEvict[K comparable, V any](onEvict func(key K, value V, reason EvictReason)) CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		c.onEvict = onEvict
+	}
+}
+
+func NewCache[K comparable, V any](options ...CacheOption[K, V]) *Cache[K, V] {
+	c := &Cache[K, V]{
+		items:       make(map[K]*CacheItem[K, V]),
+		loadErrors:  make(map[K]*loadError[K]),
+		calls:       make(map[K]*loadCall[V]),
+		loadMu:      &sync.Mutex{},
+		mu:          &sync.RWMutex{},
+		cleanupTick: time.Minute,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	if c.capacity > 0 || c.maxCost > 0 {
+		c.policy = newPolicy[K, V](c.policyKind, c.capacity)
+	}
+	if !c.bounded() { // TTL кеш
+		c.withTTL = true
+	}
+	if c.withTTL || c.negativeTTL > 0 {
+		c.stopCleaner = make(chan struct{})
+		go c.cleanupLoop()
+	}
+	return c
+}
+
+func (c *Cache[K, V]) cleanupLoop() {
+	ticker := time.NewTicker(c.cleanupTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.cleanup()
+		case <-c.stopCleaner:
+			return
+		}
+	}
+}
+
+// cleanup снимает просроченные элементы с вершины c.expQueue, поэтому держит блокировку
+// пропорционально числу просроченных элементов, а не размеру кеша
+func (c *Cache[K, V]) cleanup() {
+	c.mu.Lock()
+	defer c.unlockAndNotify()
+
+	now := time.Now()
+	for item := c.expQueue.peek(); item != nil && item.expired(now); item = c.expQueue.peek() {
+		c.remove(item, EvictExpired)
+	}
+	for le := c.loadErrExp.peek(); le != nil && now.After(le.expiration); le = c.loadErrExp.peek() {
+		c.deleteLoadErr(le.key)
+	}
+}
+
+func (c *Cache[K, V]) Close() {
+	if c.stopCleaner != nil {
+		close(c.stopCleaner)
+	}
+}
+
+// muLoad копирует значение под блокировкой: Set может изменить элемент на месте.
+// Для просроченного элемента возвращается сам элемент, чтобы удалить именно его
+func (c *Cache[K, V]) muLoad(key K) (V, *CacheItem[K, V], bool) {
+	var zero V
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	item, ok := c.items[key]
+	if !ok {
+		return zero, nil, false
+	}
+	if item.expired(time.Now()) {
+		return zero, item, false
+	}
+	return item.value, nil, true
+}
+
+// muTouch отмечает обращение к элементу в политике вытеснения, если он еще не был удален из кеша.
+// Просроченный элемент удаляется сразу, не дожидаясь cleanupLoop
+func (c *Cache[K, V]) muTouch(key K) (V, bool) {
+	var zero V
+	c.mu.Lock()
+	defer c.unlockAndNotify()
+	item, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+	if c.withTTL && item.expired(time.Now()) {
+		c.remove(item, EvictExpired)
+		return zero, false
+	}
+	c.policy.touch(item)
+	return item.value, true
+}
+
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	value, ok := c.get(key)
+	if ok {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	return value, ok
+}
+
+func (c *Cache[K, V]) get(key K) (V, bool) {
+	if c.bounded() {
+		return c.muTouch(key)
+	}
+
+	value, expired, ok := c.muLoad(key)
+	if expired != nil {
+		c.deleteItem(expired)
+	}
+	return value, ok
+}
+
+// bounded - кеш с ограниченной емкостью и политикой вытеснения
+func (c *Cache[K, V]) bounded() bool {
+	return c.policy != nil
+}
+
+func (c *Cache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.set(key, value, c.itemCost(key, value), ttl)
+}
+
+func (c *Cache[K, V]) set(key K, value V, cost int64, ttl time.Duration) {
+	var expiration time.Time
+	if ttl > 0 {
+		expiration = time.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	defer c.unlockAndNotify()
+
+	c.deleteLoadErr(key)
+	if item, ok := c.items[key]; ok {
+		if c.onEvict != nil {
+			c.evicted = append(c.evicted, eviction[K, V]{key: key, value: item.value, reason: EvictReplaced})
+		}
+		item.expiration = expiration
+		item.value = value
+		c.totalCost += cost - item.cost
+		item.cost = cost
+		c.trackExpiration(item)
+		if c.bounded() {
+			c.policy.touch(item)
+		}
+	} else {
+		newItem := &CacheItem[K, V]{key: key, value: value, expiration: expiration, expIndex: -1, cost: cost}
+		c.items[key] = newItem
+		c.totalCost += cost
+		c.trackExpiration(newItem)
+		if c.bounded() {
+			c.policy.add(newItem)
+		}
+	}
+
+	// элемент дороже maxCost вытесняется сам, освободив перед этим весь кеш
+	for c.bounded() && c.overflow() {
+		c.evict()
+	}
+}
+
+// overflow вызывается под c.mu.Lock
+func (c *Cache[K, V]) overflow() bool {
+	if c.capacity > 0 && c.policy.len() > c.capacity {
+		return true
+	}
+	return c.maxCost > 0 && c.totalCost > c.maxCost
+}
+
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.unlockAndNotify()
+
+	c.deleteLoadErr(key)
+	if item, ok := c.items[key]; ok {
+		c.remove(item, EvictDeleted)
+	}
+}
+
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.unlockAndNotify()
+
+	clear(c.loadErrors)
+	c.loadErrExp = nil
+	for _, item := range c.items {
+		c.remove(item, EvictCleared)
+	}
+}
+
+// deleteItem удаляет именно этот элемент: если ключ уже перезаписан через Set, новое значение остается в кеше
+func (c *Cache[K, V]) deleteItem(item *CacheItem[K, V]) {
+	c.mu.Lock()
+	defer c.unlockAndNotify()
+
+	if current, ok := c.items[item.key]; ok && current == item {
+		c.remove(item, EvictExpired)
+	}
+}
+
+// evict освобождает место под новый элемент: сначала раньше всех истекший элемент,
+// иначе элемент, выбранный политикой вытеснения. Вызывается под c.mu.Lock
+func (c *Cache[K, V]) evict() {
+	if item := c.expQueue.peek(); item != nil && item.expired(time.Now()) {
+		c.remove(item, EvictExpired)
+		return
+	}
+	if victim := c.policy.victim(); victim != nil {
+		c.remove(victim, EvictCapacity)
+	}
+}
+
+// remove вызывается под c.mu.Lock
+func (c *Cache[K, V]) remove(item *CacheItem[K, V], reason EvictReason) {
+	delete(c.items, item.key)
+	c.totalCost -= item.cost
+	c.expQueue.remove(item)
+	if c.bounded() {
+		c.policy.remove(item)
+	}
+	switch reason {
+	case EvictCapacity:
+		c.evictions.Add(1)
+	case EvictExpired:
+		c.expirations.Add(1)
+	}
+	if c.onEvict != nil {
+		c.evicted = append(c.evicted, eviction[K, V]{key: item.key, value: item.value, reason: reason})
+	}
+}
+
+// unlockAndNotify снимает c.mu.Lock и только после этого вызывает onEvict для удаленных элементов
+func (c *Cache[K, V]) unlockAndNotify() {
+	evicted := c.evicted
+	c.evicted = nil
+	c.mu.Unlock()
+
+	for _, e := range evicted {
+		c.onEvict(e.key, e.value, e.reason)
+	}
+}
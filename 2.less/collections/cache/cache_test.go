@@ -0,0 +1,195 @@
+package cache
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+const benchKeys = 1024
+
+func TestCacheOnEvict(t *testing.T) {
+	type event struct {
+		key    string
+		value  int
+		reason EvictReason
+	}
+	var events []event
+	c := NewCache(
+		WithCapacity[string, int](2),
+		WithExpiration[string, int](),
+		WithOnEvict(func(key string, value int, reason EvictReason) {
+			events = append(events, event{key: key, value: value, reason: reason})
+		}),
+	)
+	defer c.Close()
+
+	c.Set("expired", 1, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	c.Get("expired")
+	c.Set("a", 1, 0)
+	c.Set("b", 2, 0)
+	c.Set("c", 3, 0)
+	c.Delete("b")
+	c.Set("c", 4, 0)
+	c.Clear()
+
+	expected := []event{
+		{key: "expired", value: 1, reason: EvictExpired},
+		{key: "a", value: 1, reason: EvictCapacity},
+		{key: "b", value: 2, reason: EvictDeleted},
+		{key: "c", value: 3, reason: EvictReplaced},
+		{key: "c", value: 4, reason: EvictCleared},
+	}
+	if !slices.Equal(events, expected) {
+		t.Errorf("events = %+v, expected %+v", events, expected)
+	}
+}
+
+func TestCacheStats(t *testing.T) {
+	c := NewCache(WithCapacity[string, int](2), WithExpiration[string, int]())
+	defer c.Close()
+
+	c.Set("expired", 0, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	c.Get("expired")
+	c.Set("a", 1, 0)
+	c.Set("b", 2, 0)
+	c.Set("c", 3, 0)
+	c.Get("b")
+	c.Get("a")
+
+	expected := Stats{Hits: 1, Misses: 2, Evictions: 1, Expirations: 1, Size: 2, Capacity: 2, Cost: 2}
+	if s := c.Stats(); s != expected {
+		t.Errorf("Stats() = %+v, expected %+v", s, expected)
+	}
+}
+
+// TestCacheConcurrentGetSet запускается с -race: Get не должен читать элемент, который Set меняет на месте
+func TestCacheConcurrentGetSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []CacheOption[string, int]
+	}{
+		{name: "TTL", options: nil},
+		{name: "LRU", options: []CacheOption[string, int]{WithCapacity[string, int](2)}},
+		{name: "LRU+TTL", options: []CacheOption[string, int]{WithCapacity[string, int](2), WithExpiration[string, int]()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := NewCache(tt.options...)
+				defer c.Close()
+				c.Set("k", 0, 0)
+
+				var wg sync.WaitGroup
+				for g := 0; g < 4; g++ {
+					wg.Add(2)
+					go func() {
+						defer wg.Done()
+						for i := 0; i < 1000; i++ {
+							c.Get("k")
+						}
+					}()
+					go func() {
+						defer wg.Done()
+						for i := 0; i < 1000; i++ {
+							c.Set("k", i, time.Minute)
+						}
+					}()
+				}
+				wg.Wait()
+
+				if _, ok := c.Get("k"); !ok {
+					t.Errorf("Get(k) returned !ok")
+				}
+			},
+		)
+	}
+}
+
+func TestShardedCache(t *testing.T) {
+	c := NewShardedCache(4, WithCapacity[string, int](8))
+	defer c.Close()
+
+	for i := 0; i < 8; i++ {
+		c.Set("key"+strconv.Itoa(i), i, 0)
+	}
+	for _, shard := range c.shards {
+		if shard.capacity != 2 {
+			t.Fatalf("shard capacity = %d, expected 2", shard.capacity)
+		}
+	}
+
+	c.Set("key0", 100, 0)
+	if value, ok := c.Get("key0"); !ok || value != 100 {
+		t.Errorf("Get(key0) = %v, %v, expected 100, true", value, ok)
+	}
+
+	c.Delete("key0")
+	if _, ok := c.Get("key0"); ok {
+		t.Errorf("Get(key0) after Delete returned ok")
+	}
+}
+
+type benchCache interface {
+	Get(key string) (int, bool)
+	Set(key string, value int, ttl time.Duration)
+}
+
+func fillBenchCache(c benchCache) []string {
+	keys := make([]string, benchKeys)
+	for i := range keys {
+		keys[i] = "key" + strconv.Itoa(i)
+		c.Set(keys[i], i, 0)
+	}
+	return keys
+}
+
+func benchmarkParallelGet(b *testing.B, c benchCache) {
+	keys := fillBenchCache(c)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			c.Get(keys[i%benchKeys])
+			i++
+		}
+	})
+}
+
+func benchmarkParallelGetSet(b *testing.B, c benchCache) {
+	keys := fillBenchCache(c)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := keys[i%benchKeys]
+			if i%4 == 0 {
+				c.Set(key, i, 0)
+			} else {
+				c.Get(key)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkCacheParallelGet(b *testing.B) {
+	benchmarkParallelGet(b, NewCache(WithCapacity[string, int](benchKeys)))
+}
+
+func BenchmarkShardedCacheParallelGet(b *testing.B) {
+	benchmarkParallelGet(b, NewShardedCache(16, WithCapacity[string, int](benchKeys*2)))
+}
+
+func BenchmarkCacheParallelGetSet(b *testing.B) {
+	benchmarkParallelGetSet(b, NewCache(WithCapacity[string, int](benchKeys)))
+}
+
+func BenchmarkShardedCacheParallelGetSet(b *testing.B) {
+	benchmarkParallelGetSet(b, NewShardedCache(16, WithCapacity[string, int](benchKeys*2)))
+}
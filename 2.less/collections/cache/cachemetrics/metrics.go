@@ -0,0 +1,64 @@
+// Пакет cachemetrics вынесен из cache, чтобы сам кеш не зависел от prometheus
+package cachemetrics
+
+import (
+	"2less/collections/cache"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type StatsProvider interface {
+	Stats() cache.Stats
+}
+
+// StatsCollector отдает Stats кеша в prometheus. Регистрируется рядом с остальными метриками сервиса:
+// prometheus.MustRegister(cachemetrics.NewStatsCollector("chat_history", c))
+type StatsCollector struct {
+	provider    StatsProvider
+	hits        *prometheus.Desc
+	misses      *prometheus.Desc
+	evictions   *prometheus.Desc
+	expirations *prometheus.Desc
+	size        *prometheus.Desc
+	capacity    *prometheus.Desc
+	cost        *prometheus.Desc
+	maxCost     *prometheus.Desc
+}
+
+func NewStatsCollector(name string, provider StatsProvider) *StatsCollector {
+	labels := prometheus.Labels{"cache": name}
+	return &StatsCollector{
+		provider:    provider,
+		hits:        prometheus.NewDesc("cache_hits_total", "The total number of cache hits", nil, labels),
+		misses:      prometheus.NewDesc("cache_misses_total", "The total number of cache misses", nil, labels),
+		evictions:   prometheus.NewDesc("cache_evictions_total", "The total number of capacity evictions", nil, labels),
+		expirations: prometheus.NewDesc("cache_expirations_total", "The total number of expired items", nil, labels),
+		size:        prometheus.NewDesc("cache_size", "The current number of items in cache", nil, labels),
+		capacity:    prometheus.NewDesc("cache_capacity", "The cache capacity, 0 if unbounded", nil, labels),
+		cost:        prometheus.NewDesc("cache_cost", "The total cost of items in cache", nil, labels),
+		maxCost:     prometheus.NewDesc("cache_max_cost", "The cache cost limit, 0 if unbounded", nil, labels),
+	}
+}
+
+func (sc *StatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sc.hits
+	ch <- sc.misses
+	ch <- sc.evictions
+	ch <- sc.expirations
+	ch <- sc.size
+	ch <- sc.capacity
+	ch <- sc.cost
+	ch <- sc.maxCost
+}
+
+func (sc *StatsCollector) Collect(ch chan<- prometheus.Metric) {
+	s := sc.provider.Stats()
+	ch <- prometheus.MustNewConstMetric(sc.hits, prometheus.CounterValue, float64(s.Hits))
+	ch <- prometheus.MustNewConstMetric(sc.misses, prometheus.CounterValue, float64(s.Misses))
+	ch <- prometheus.MustNewConstMetric(sc.evictions, prometheus.CounterValue, float64(s.Evictions))
+	ch <- prometheus.MustNewConstMetric(sc.expirations, prometheus.CounterValue, float64(s.Expirations))
+	ch <- prometheus.MustNewConstMetric(sc.size, prometheus.GaugeValue, float64(s.Size))
+	ch <- prometheus.MustNewConstMetric(sc.capacity, prometheus.GaugeValue, float64(s.Capacity))
+	ch <- prometheus.MustNewConstMetric(sc.cost, prometheus.GaugeValue, float64(s.Cost))
+	ch <- prometheus.MustNewConstMetric(sc.maxCost, prometheus.GaugeValue, float64(s.MaxCost))
+}
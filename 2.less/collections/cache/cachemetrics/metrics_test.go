@@ -0,0 +1,55 @@
+package cachemetrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"2less/collections/cache"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestStatsCollector(t *testing.T) {
+	c := cache.NewCache(cache.WithCapacity[string, int](2), cache.WithExpiration[string, int]())
+	defer c.Close()
+
+	c.Set("expired", 0, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	c.Get("expired")
+	c.Set("a", 1, 0)
+	c.Set("b", 2, 0)
+	c.Set("c", 3, 0)
+	c.Get("b")
+	c.Get("a")
+
+	expected := `
+# HELP cache_capacity The cache capacity, 0 if unbounded
+# TYPE cache_capacity gauge
+cache_capacity{cache="test"} 2
+# HELP cache_cost The total cost of items in cache
+# TYPE cache_cost gauge
+cache_cost{cache="test"} 2
+# HELP cache_evictions_total The total number of capacity evictions
+# TYPE cache_evictions_total counter
+cache_evictions_total{cache="test"} 1
+# HELP cache_expirations_total The total number of expired items
+# TYPE cache_expirations_total counter
+cache_expirations_total{cache="test"} 1
+# HELP cache_hits_total The total number of cache hits
+# TYPE cache_hits_total counter
+cache_hits_total{cache="test"} 1
+# HELP cache_max_cost The cache cost limit, 0 if unbounded
+# TYPE cache_max_cost gauge
+cache_max_cost{cache="test"} 0
+# HELP cache_misses_total The total number of cache misses
+# TYPE cache_misses_total counter
+cache_misses_total{cache="test"} 2
+# HELP cache_size The current number of items in cache
+# TYPE cache_size gauge
+cache_size{cache="test"} 2
+`
+	if err := testutil.CollectAndCompare(NewStatsCollector("test", c), strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
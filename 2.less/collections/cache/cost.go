@@ -0,0 +1,35 @@
+package cache
+
+import "time"
+
+// WithMaxCost ограничивает суммарную стоимость элементов (например, размер в байтах). Стоимость элемента
+// задается через WithCost или SetWithCost, по умолчанию каждый элемент стоит 1.
+// Можно сочетать с WithCapacity: вытеснение идет, пока не выполнены оба ограничения
+func WithMaxCost[K comparable, V any](maxCost int64) CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		c.maxCost = maxCost
+	}
+}
+
+// WithCost задает функцию, которая считает стоимость элемента при Set
+func WithCost[K comparable, V any](cost func(key K, value V) int64) CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		c.costFunc = cost
+	}
+}
+
+// SetWithCost сохраняет значение с явно заданной стоимостью, не вызывая функцию из WithCost
+func (c *Cache[K, V]) SetWithCost(key K, value V, cost int64, ttl time.Duration) {
+	c.set(key, value, cost, ttl)
+}
+
+func (c *Cache[K, V]) itemCost(key K, value V) int64 {
+	if c.costFunc == nil {
+		return 1
+	}
+	return c.costFunc(key, value)
+}
+
+func (sc *ShardedCache[K, V]) SetWithCost(key K, value V, cost int64, ttl time.Duration) {
+	sc.shard(key).SetWithCost(key, value, cost, ttl)
+}
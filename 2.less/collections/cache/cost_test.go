@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMaxCostEvictsEnoughWeight(t *testing.T) {
+	var evicted []string
+	c := NewCache(
+		WithMaxCost[string, string](10),
+		WithCost(func(_ string, value string) int64 { return int64(len(value)) }),
+		WithOnEvict(func(key string, _ string, _ EvictReason) { evicted = append(evicted, key) }),
+	)
+
+	c.Set("a", "aaa", 0)
+	c.Set("b", "bbb", 0)
+	c.Set("c", "ccc", 0)
+	c.Set("big", "bbbbbbb", 0) // 7 байт: нужно вытеснить два старых элемента
+
+	if s := c.Stats(); s.Cost != 10 || s.Size != 2 {
+		t.Errorf("Stats() cost = %d, size = %d, expected 10, 2", s.Cost, s.Size)
+	}
+	if strings.Join(evicted, ",") != "a,b" {
+		t.Errorf("evicted = %v, expected [a b]", evicted)
+	}
+
+	c.SetWithCost("huge", "x", 11, 0)
+	if _, ok := c.Get("huge"); ok {
+		t.Error("item more expensive than max cost was kept")
+	}
+	if s := c.Stats(); s.Cost != 0 || s.Size != 0 {
+		t.Errorf("Stats() cost = %d, size = %d, expected empty cache", s.Cost, s.Size)
+	}
+}
+
+func TestMaxCostUpdateExistingKey(t *testing.T) {
+	c := NewCache(WithMaxCost[string, int](5))
+	c.SetWithCost("a", 1, 2, 0)
+	c.SetWithCost("b", 2, 2, 0)
+	c.SetWithCost("b", 3, 3, 0)
+
+	if s := c.Stats(); s.Cost != 5 || s.Size != 2 {
+		t.Errorf("Stats() cost = %d, size = %d, expected 5, 2", s.Cost, s.Size)
+	}
+
+	c.SetWithCost("b", 4, 4, 0)
+	if _, ok := c.Get("a"); ok {
+		t.Error("growing an item did not evict older items")
+	}
+}
@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"container/heap"
+	"time"
+)
+
+// WithCleanupInterval задает, как часто cleanupLoop удаляет просроченные элементы (по умолчанию раз в минуту)
+func WithCleanupInterval[K comparable, V any](interval time.Duration) CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		if interval > 0 {
+			c.cleanupTick = interval
+		}
+	}
+}
+
+// trackExpiration обновляет положение элемента в очереди истечения после изменения expiration.
+// Вызывается под c.mu.Lock
+func (c *Cache[K, V]) trackExpiration(item *CacheItem[K, V]) {
+	switch {
+	case !c.withTTL || item.expiration.IsZero():
+		c.expQueue.remove(item)
+	case item.expIndex >= 0:
+		heap.Fix(&c.expQueue, item.expIndex)
+	default:
+		heap.Push(&c.expQueue, item)
+	}
+}
+
+// expirationQueue - min-heap элементов по времени истечения TTL
+type expirationQueue[K comparable, V any] []*CacheItem[K, V]
+
+func (q expirationQueue[K, V]) peek() *CacheItem[K, V] {
+	if len(q) == 0 {
+		return nil
+	}
+	return q[0]
+}
+
+func (q *expirationQueue[K, V]) remove(item *CacheItem[K, V]) {
+	if item.expIndex >= 0 {
+		heap.Remove(q, item.expIndex)
+	}
+}
+
+func (q expirationQueue[K, V]) Len() int { return len(q) }
+
+func (q expirationQueue[K, V]) Less(i, j int) bool {
+	return q[i].expiration.Before(q[j].expiration)
+}
+
+func (q expirationQueue[K, V]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].expIndex = i
+	q[j].expIndex = j
+}
+
+func (q *expirationQueue[K, V]) Push(x any) {
+	item := x.(*CacheItem[K, V])
+	item.expIndex = len(*q)
+	*q = append(*q, item)
+}
+
+func (q *expirationQueue[K, V]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	item.expIndex = -1
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCleanupRemovesExpiredOnly(t *testing.T) {
+	c := NewCache(WithCleanupInterval[string, int](time.Hour))
+	defer c.Close()
+
+	c.Set("expired", 1, time.Nanosecond)
+	c.Set("alive", 2, time.Hour)
+	c.Set("forever", 3, 0)
+	c.Set("renewed", 4, time.Nanosecond)
+	c.Set("renewed", 4, 0)
+	time.Sleep(time.Millisecond)
+
+	c.cleanup()
+
+	if _, ok := c.items["expired"]; ok {
+		t.Error("expired item was not removed")
+	}
+	for _, key := range []string{"alive", "forever", "renewed"} {
+		if _, ok := c.items[key]; !ok {
+			t.Errorf("item %s was removed", key)
+		}
+	}
+	if len(c.expQueue) != 1 {
+		t.Errorf("expiration queue holds %d items, expected 1", len(c.expQueue))
+	}
+}
+
+func TestCleanupInterval(t *testing.T) {
+	expired := make(chan string, 1)
+	c := NewCache(
+		WithCleanupInterval[string, int](5*time.Millisecond),
+		WithOnEvict(func(key string, _ int, reason EvictReason) {
+			if reason == EvictExpired {
+				expired <- key
+			}
+		}),
+	)
+	defer c.Close()
+
+	c.Set("key", 1, time.Millisecond)
+
+	select {
+	case key := <-expired:
+		if key != "key" {
+			t.Errorf("expired key = %s, expected key", key)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("cleanupLoop did not remove expired item")
+	}
+}
+
+func TestCleanupLoopBoundedCache(t *testing.T) {
+	c := NewCache(
+		WithCapacity[string, int](4),
+		WithExpiration[string, int](),
+		WithCleanupInterval[string, int](time.Millisecond),
+	)
+	defer c.Close()
+
+	c.Set("expired1", 1, time.Millisecond)
+	c.Set("expired2", 2, time.Millisecond)
+	c.Set("alive", 3, time.Hour)
+	c.Set("forever", 4, 0)
+
+	// cleanupLoop должен убрать просроченные элементы и из items, и из политики вытеснения без вызова Get
+	deadline := time.Now().Add(time.Second)
+	for {
+		c.mu.RLock()
+		items, policyLen := len(c.items), c.policy.len()
+		c.mu.RUnlock()
+		if items == 2 {
+			if policyLen != items {
+				t.Fatalf("policy holds %d items, cache holds %d", policyLen, items)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("cache holds %d items after cleanup, expected 2", items)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	for _, key := range []string{"alive", "forever"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("item %s was removed", key)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+)
+
+// FuzzLRUOrder сверяет порядок LRU с простой моделью на срезе: каждый байт входа - операция над ключом
+func FuzzLRUOrder(f *testing.F) {
+	f.Add([]byte{0, 1, 2, 3, 0x41, 4, 0x82, 5})
+	f.Add([]byte{0x10, 0x11, 0x12, 0x13, 0x10, 0x50, 0x14})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		const capacity = 4
+		c := NewCache(WithCapacity[byte, int](capacity))
+		var model []byte // от самого свежего к самому старому
+
+		touch := func(key byte) {
+			model = slices.DeleteFunc(model, func(k byte) bool { return k == key })
+			model = slices.Insert(model, 0, key)
+		}
+
+		for i, op := range ops {
+			key := op & 0x0f
+			switch op >> 6 {
+			case 0, 3:
+				c.Set(key, i, 0)
+				touch(key)
+				if len(model) > capacity {
+					model = model[:capacity]
+				}
+			case 1:
+				_, ok := c.Get(key)
+				if ok != slices.Contains(model, key) {
+					t.Fatalf("Get(%d) ok = %v, model %v", key, ok, model)
+				}
+				if ok {
+					touch(key)
+				}
+			case 2:
+				c.Delete(key)
+				model = slices.DeleteFunc(model, func(k byte) bool { return k == key })
+			}
+
+			var order []byte
+			for item := range c.policy.all() {
+				order = append(order, item.key)
+			}
+			if !slices.Equal(order, model) {
+				t.Fatalf("after op %d LRU order = %v, expected %v", i, order, model)
+			}
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"container/heap"
+	"iter"
+	"slices"
+)
+
+// lfuPolicy - min-heap по числу обращений. При равной частоте вытесняется элемент,
+// к которому дольше всего не обращались
+type lfuPolicy[K comparable, V any] struct {
+	heap lfuHeap[K, V]
+	tick uint64
+}
+
+func newLFUPolicy[K comparable, V any]() *lfuPolicy[K, V] {
+	return &lfuPolicy[K, V]{}
+}
+
+func (p *lfuPolicy[K, V]) add(item *CacheItem[K, V]) {
+	p.tick++
+	item.freq = 1
+	item.lastAccess = p.tick
+	heap.Push(&p.heap, item)
+}
+
+func (p *lfuPolicy[K, V]) touch(item *CacheItem[K, V]) {
+	p.tick++
+	item.freq++
+	item.lastAccess = p.tick
+	heap.Fix(&p.heap, item.heapIndex)
+}
+
+func (p *lfuPolicy[K, V]) remove(item *CacheItem[K, V]) {
+	heap.Remove(&p.heap, item.heapIndex)
+}
+
+func (p *lfuPolicy[K, V]) victim() *CacheItem[K, V] {
+	if len(p.heap) == 0 {
+		return nil
+	}
+	return p.heap[0]
+}
+
+func (p *lfuPolicy[K, V]) all() iter.Seq[*CacheItem[K, V]] {
+	items := slices.Clone(p.heap)
+	slices.SortFunc(items, func(a, b *CacheItem[K, V]) int {
+		if p.heap.less(a, b) {
+			return 1
+		}
+		if p.heap.less(b, a) {
+			return -1
+		}
+		return 0
+	})
+	return slices.Values(items)
+}
+
+func (p *lfuPolicy[K, V]) len() int {
+	return len(p.heap)
+}
+
+type lfuHeap[K comparable, V any] []*CacheItem[K, V]
+
+func (h lfuHeap[K, V]) less(a, b *CacheItem[K, V]) bool {
+	if a.freq != b.freq {
+		return a.freq < b.freq
+	}
+	return a.lastAccess < b.lastAccess
+}
+
+func (h lfuHeap[K, V]) Len() int           { return len(h) }
+func (h lfuHeap[K, V]) Less(i, j int) bool { return h.less(h[i], h[j]) }
+
+func (h lfuHeap[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
+}
+
+func (h *lfuHeap[K, V]) Push(x any) {
+	item := x.(*CacheItem[K, V])
+	item.heapIndex = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *lfuHeap[K, V]) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"container/heap"
+	"context"
+	"time"
+)
+
+type Loader[K comparable, V any] func(ctx context.Context, key K) (V, error)
+
+type loadError[K comparable] struct {
+	key        K
+	err        error
+	expiration time.Time
+	index      int // индекс в c.loadErrExp
+}
+
+// loadCall - загрузка ключа, которую ждут все конкурентные GetOrLoad
+type loadCall[V any] struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	value   V
+	err     error
+}
+
+// WithLoadTTL задает TTL для значений, загруженных через GetOrLoad
+func WithLoadTTL[K comparable, V any](ttl time.Duration) CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		c.loadTTL = ttl
+	}
+}
+
+// WithNegativeTTL включает кеширование ошибок загрузчика: в течение ttl GetOrLoad возвращает ту же ошибку,
+// не вызывая загрузчик повторно. Просроченные ошибки удаляет cleanupLoop, он запускается и для кеша без TTL
+func WithNegativeTTL[K comparable, V any](ttl time.Duration) CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		c.negativeTTL = ttl
+	}
+}
+
+// GetOrLoad возвращает значение из кеша, а при промахе загружает его через loader и сохраняет в кеш.
+// Конкурентные вызовы для одного ключа выполняют только одну загрузку. Отмена ctx прерывает ожидание
+// только для этого вызова; сама загрузка отменяется, когда ее больше никто не ждет
+func (c *Cache[K, V]) GetOrLoad(ctx context.Context, key K, loader Loader[K, V]) (V, error) {
+	var zero V
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+	if err, ok := c.loadErr(key); ok {
+		return zero, err
+	}
+
+	c.loadMu.Lock()
+	call, ok := c.calls[key]
+	if !ok {
+		loadCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &loadCall[V]{done: make(chan struct{}), cancel: cancel}
+		c.calls[key] = call
+		go c.load(loadCtx, key, call, loader)
+	}
+	call.waiters++
+	c.loadMu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		c.loadMu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+			if c.calls[key] == call {
+				delete(c.calls, key)
+			}
+		}
+		c.loadMu.Unlock()
+		return zero, ctx.Err()
+	}
+}
+
+func (c *Cache[K, V]) load(ctx context.Context, key K, call *loadCall[V], loader Loader[K, V]) {
+	defer call.cancel()
+
+	value, err := loader(ctx, key)
+	switch {
+	case err == nil:
+		c.Set(key, value, c.loadTTL)
+	case c.negativeTTL > 0 && ctx.Err() == nil:
+		c.setLoadErr(key, err)
+	}
+
+	c.loadMu.Lock()
+	if c.calls[key] == call {
+		delete(c.calls, key)
+	}
+	c.loadMu.Unlock()
+
+	call.value, call.err = value, err
+	close(call.done)
+}
+
+// loadErr возвращает закешированную ошибку загрузки. Просроченная ошибка удаляется сразу,
+// не дожидаясь cleanupLoop
+func (c *Cache[K, V]) loadErr(key K) (error, bool) {
+	c.mu.RLock()
+	le, ok := c.loadErrors[key]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	if time.Now().Before(le.expiration) {
+		return le.err, true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if le, ok := c.loadErrors[key]; ok && time.Now().After(le.expiration) {
+		c.deleteLoadErr(key)
+	}
+	return nil, false
+}
+
+func (c *Cache[K, V]) setLoadErr(key K, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiration := time.Now().Add(c.negativeTTL)
+	if le, ok := c.loadErrors[key]; ok {
+		le.err, le.expiration = err, expiration
+		heap.Fix(&c.loadErrExp, le.index)
+		return
+	}
+	le := &loadError[K]{key: key, err: err, expiration: expiration}
+	c.loadErrors[key] = le
+	heap.Push(&c.loadErrExp, le)
+}
+
+// deleteLoadErr вызывается под c.mu.Lock
+func (c *Cache[K, V]) deleteLoadErr(key K) {
+	if le, ok := c.loadErrors[key]; ok {
+		delete(c.loadErrors, key)
+		heap.Remove(&c.loadErrExp, le.index)
+	}
+}
+
+// loadErrorQueue - min-heap закешированных ошибок по времени истечения, чтобы cleanup
+// не перебирал все ошибки под блокировкой
+type loadErrorQueue[K comparable] []*loadError[K]
+
+func (q loadErrorQueue[K]) peek() *loadError[K] {
+	if len(q) == 0 {
+		return nil
+	}
+	return q[0]
+}
+
+func (q loadErrorQueue[K]) Len() int { return len(q) }
+
+func (q loadErrorQueue[K]) Less(i, j int) bool {
+	return q[i].expiration.Before(q[j].expiration)
+}
+
+func (q loadErrorQueue[K]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *loadErrorQueue[K]) Push(x any) {
+	le := x.(*loadError[K])
+	le.index = len(*q)
+	*q = append(*q, le)
+}
+
+func (q *loadErrorQueue[K]) Pop() any {
+	old := *q
+	le := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return le
+}
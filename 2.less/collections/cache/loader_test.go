@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetOrLoadDeduplicates(t *testing.T) {
+	c := NewCache(WithCapacity[string, int](10))
+	var calls atomic.Int32
+	release := make(chan struct{})
+	loader := func(_ context.Context, _ string) (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := c.GetOrLoad(context.Background(), "key", loader)
+			if err != nil {
+				t.Errorf("GetOrLoad() error = %v", err)
+			}
+			results[i] = value
+		}()
+	}
+	for {
+		c.loadMu.Lock()
+		call := c.calls["key"]
+		waiters := 0
+		if call != nil {
+			waiters = call.waiters
+		}
+		c.loadMu.Unlock()
+		if waiters == len(results) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("loader called %d times, expected 1", calls.Load())
+	}
+	for _, value := range results {
+		if value != 42 {
+			t.Errorf("GetOrLoad() = %d, expected 42", value)
+		}
+	}
+	if value, ok := c.Get("key"); !ok || value != 42 {
+		t.Errorf("Get() = %v, %v, expected loaded value in cache", value, ok)
+	}
+}
+
+func TestGetOrLoadNegativeTTL(t *testing.T) {
+	c := NewCache(WithCapacity[string, int](10), WithNegativeTTL[string, int](time.Minute))
+	errLoad := errors.New("load failed")
+	var calls int
+	loader := func(_ context.Context, _ string) (int, error) {
+		calls++
+		return 0, errLoad
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetOrLoad(context.Background(), "key", loader); !errors.Is(err, errLoad) {
+			t.Fatalf("GetOrLoad() error = %v, expected %v", err, errLoad)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("loader called %d times, expected 1", calls)
+	}
+
+	c.Set("key", 1, 0)
+	if value, err := c.GetOrLoad(context.Background(), "key", loader); err != nil || value != 1 {
+		t.Errorf("GetOrLoad() after Set = %v, %v, expected 1, nil", value, err)
+	}
+}
+
+func TestGetOrLoadNegativeTTLExpires(t *testing.T) {
+	c := NewCache(
+		WithCapacity[string, int](10),
+		WithNegativeTTL[string, int](time.Millisecond),
+		WithCleanupInterval[string, int](time.Hour),
+	)
+	defer c.Close()
+	if c.stopCleaner == nil {
+		t.Fatal("cleanupLoop is not started with WithNegativeTTL")
+	}
+	errLoad := errors.New("load failed")
+	loader := func(_ context.Context, _ string) (int, error) {
+		return 0, errLoad
+	}
+
+	c.GetOrLoad(context.Background(), "requested", loader)
+	c.GetOrLoad(context.Background(), "forgotten", loader)
+	time.Sleep(2 * time.Millisecond)
+
+	// повторный запрос удаляет просроченную ошибку сразу
+	c.loadErr("requested")
+	c.mu.RLock()
+	_, ok := c.loadErrors["requested"]
+	c.mu.RUnlock()
+	if ok {
+		t.Error("expired load error was not removed on lookup")
+	}
+
+	// ошибки по ключам, которые больше не запрашивают, удаляет cleanup; непросроченные остаются
+	c.negativeTTL = time.Hour
+	c.GetOrLoad(context.Background(), "alive", loader)
+	c.cleanup()
+	if _, ok := c.loadErrors["alive"]; !ok || len(c.loadErrors) != 1 {
+		t.Errorf("loadErrors holds %d items after cleanup, expected only alive", len(c.loadErrors))
+	}
+	if len(c.loadErrExp) != len(c.loadErrors) {
+		t.Errorf("expiration queue holds %d errors, expected %d", len(c.loadErrExp), len(c.loadErrors))
+	}
+}
+
+func TestGetOrLoadContextCancel(t *testing.T) {
+	c := NewCache(WithCapacity[string, int](10))
+	loadCanceled := make(chan struct{})
+	loader := func(ctx context.Context, _ string) (int, error) {
+		<-ctx.Done()
+		close(loadCanceled)
+		return 0, ctx.Err()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetOrLoad(ctx, "key", loader); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetOrLoad() error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+
+	select {
+	case <-loadCanceled:
+	case <-time.After(time.Second):
+		t.Fatal("loader context was not canceled")
+	}
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"container/list"
+	"iter"
+)
+
+type lruPolicy[K comparable, V any] struct {
+	list *list.List
+}
+
+func newLRUPolicy[K comparable, V any]() *lruPolicy[K, V] {
+	return &lruPolicy[K, V]{list: list.New()}
+}
+
+func (p *lruPolicy[K, V]) add(item *CacheItem[K, V]) {
+	item.element = p.list.PushFront(item)
+}
+
+func (p *lruPolicy[K, V]) touch(item *CacheItem[K, V]) {
+	p.list.MoveToFront(item.element)
+}
+
+func (p *lruPolicy[K, V]) remove(item *CacheItem[K, V]) {
+	p.list.Remove(item.element)
+}
+
+func (p *lruPolicy[K, V]) victim() *CacheItem[K, V] {
+	return back[K, V](p.list)
+}
+
+func (p *lruPolicy[K, V]) all() iter.Seq[*CacheItem[K, V]] {
+	return listItems[K, V](p.list)
+}
+
+func (p *lruPolicy[K, V]) len() int {
+	return p.list.Len()
+}
+
+func back[K comparable, V any](l *list.List) *CacheItem[K, V] {
+	if e := l.Back(); e != nil {
+		return e.Value.(*CacheItem[K, V])
+	}
+	return nil
+}
+
+func listItems[K comparable, V any](l *list.List) iter.Seq[*CacheItem[K, V]] {
+	return func(yield func(*CacheItem[K, V]) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.(*CacheItem[K, V])) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package cache
+
+import "iter"
+
+type Policy int
+
+const (
+	LRU     Policy = iota // вытесняется элемент, к которому дольше всего не обращались
+	LFU                   // вытесняется элемент с наименьшим числом обращений
+	TinyLFU               // W-TinyLFU: LRU-окно + SLRU с допуском по частоте из count-min sketch
+)
+
+func (p Policy) String() string {
+	switch p {
+	case LRU:
+		return "LRU"
+	case LFU:
+		return "LFU"
+	case TinyLFU:
+		return "TinyLFU"
+	default:
+		return "unknown"
+	}
+}
+
+// evictionPolicy хранит порядок вытеснения элементов кеша с ограниченной емкостью.
+// Все методы вызываются под c.mu.Lock
+type evictionPolicy[K comparable, V any] interface {
+	add(item *CacheItem[K, V])
+	touch(item *CacheItem[K, V])
+	remove(item *CacheItem[K, V])
+	// victim выбирает элемент для вытеснения, когда элементов больше, чем позволяет емкость
+	victim() *CacheItem[K, V]
+	// all перебирает элементы от самого ценного к наименее ценному
+	all() iter.Seq[*CacheItem[K, V]]
+	len() int
+}
+
+// WithPolicy задает политику вытеснения для кеша с ограниченной емкостью, по умолчанию LRU
+func WithPolicy[K comparable, V any](policy Policy) CacheOption[K, V] {
+	return func(c *Cache[K, V]) {
+		c.policyKind = policy
+	}
+}
+
+// tinyLFUDefaultCapacity - размер sketch и начальный размер сегментов TinyLFU, если емкость задана только
+// через WithMaxCost. При заполнении кеша сегменты пересчитываются по фактическому числу элементов
+const tinyLFUDefaultCapacity = 1000
+
+func newPolicy[K comparable, V any](policy Policy, capacity int) evictionPolicy[K, V] {
+	switch policy {
+	case LFU:
+		return newLFUPolicy[K, V]()
+	case TinyLFU:
+		if capacity <= 0 {
+			capacity = tinyLFUDefaultCapacity
+		}
+		return newTinyLFUPolicy[K, V](capacity)
+	default:
+		return newLRUPolicy[K, V]()
+	}
+}
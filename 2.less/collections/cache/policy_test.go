@@ -0,0 +1,192 @@
+package cache
+
+import (
+	"bufio"
+	"flag"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"testing"
+)
+
+var traceFile = flag.String("trace", "", "файл с записанной трассой ключей для BenchmarkPolicyHitRatio, по одному ключу на строку")
+
+var policies = []Policy{LRU, LFU, TinyLFU}
+
+func TestPolicyInvariants(t *testing.T) {
+	for _, policy := range policies {
+		t.Run(policy.String(), func(t *testing.T) {
+			const capacity = 50
+			c := NewCache(WithCapacity[int, int](capacity), WithPolicy[int, int](policy))
+			r := rand.New(rand.NewPCG(1, 2))
+
+			for i := 0; i < 10000; i++ {
+				key := r.IntN(200)
+				switch r.IntN(4) {
+				case 0:
+					c.Delete(key)
+				case 1:
+					c.Get(key)
+				default:
+					c.Set(key, i, 0)
+				}
+
+				if len(c.items) > capacity {
+					t.Fatalf("size %d exceeds capacity %d", len(c.items), capacity)
+				}
+				if c.policy.len() != len(c.items) {
+					t.Fatalf("policy tracks %d items, cache has %d", c.policy.len(), len(c.items))
+				}
+			}
+
+			seen := 0
+			for item := range c.policy.all() {
+				if c.items[item.key] != item {
+					t.Fatalf("policy item %d is not in cache", item.key)
+				}
+				seen++
+			}
+			if seen != len(c.items) {
+				t.Errorf("all() returned %d items, expected %d", seen, len(c.items))
+			}
+		})
+	}
+}
+
+func TestLFUEvictsLeastFrequent(t *testing.T) {
+	c := NewCache(WithCapacity[string, int](2), WithPolicy[string, int](LFU))
+	c.Set("hot", 1, 0)
+	c.Set("cold", 2, 0)
+	c.Get("hot")
+	c.Get("hot")
+	c.Set("new", 3, 0)
+
+	if _, ok := c.Get("cold"); ok {
+		t.Error("least frequently used key was not evicted")
+	}
+	if _, ok := c.Get("hot"); !ok {
+		t.Error("frequently used key was evicted")
+	}
+}
+
+func TestTinyLFUScanResistance(t *testing.T) {
+	const capacity = 100
+	c := NewCache(WithCapacity[string, int](capacity), WithPolicy[string, int](TinyLFU))
+	hot := make([]string, capacity/2)
+	for i := range hot {
+		hot[i] = "hot" + strconv.Itoa(i)
+		c.Set(hot[i], i, 0)
+	}
+	for round := 0; round < 3; round++ {
+		for _, key := range hot {
+			c.Get(key)
+		}
+	}
+
+	// однократный проход по большому числу новых ключей не должен вымыть популярные
+	for i := 0; i < capacity*10; i++ {
+		c.Set("scan"+strconv.Itoa(i), i, 0)
+	}
+
+	kept := 0
+	for _, key := range hot {
+		if _, ok := c.Get(key); ok {
+			kept++
+		}
+	}
+	if kept < len(hot)*9/10 {
+		t.Errorf("kept %d of %d hot keys after scan", kept, len(hot))
+	}
+}
+
+func TestTinyLFUMaxCost(t *testing.T) {
+	const maxCost = 5000
+	c := NewCache(WithMaxCost[string, int](maxCost), WithPolicy[string, int](TinyLFU))
+	hot := make([]string, 100)
+	for i := range hot {
+		hot[i] = "hot" + strconv.Itoa(i)
+		c.Set(hot[i], i, 0)
+	}
+	for round := 0; round < 3; round++ {
+		for _, key := range hot {
+			c.Get(key)
+		}
+	}
+
+	// емкость в элементах не задана, поэтому окно должно подстроиться под число элементов в кеше
+	for i := 0; i < maxCost*3; i++ {
+		c.Set("scan"+strconv.Itoa(i), i, 0)
+	}
+
+	p := c.policy.(*tinyLFUPolicy[string, int])
+	if len(c.items) != maxCost || p.len() != len(c.items) {
+		t.Fatalf("cache holds %d items, policy %d, expected %d", len(c.items), p.len(), maxCost)
+	}
+	if p.window.Len() > p.windowCap+1 {
+		t.Errorf("window holds %d items, expected at most %d", p.window.Len(), p.windowCap+1)
+	}
+
+	kept := 0
+	for _, key := range hot {
+		if _, ok := c.Get(key); ok {
+			kept++
+		}
+	}
+	if kept < len(hot)*9/10 {
+		t.Errorf("kept %d of %d hot keys after scan", kept, len(hot))
+	}
+}
+
+// BenchmarkPolicyHitRatio сравнивает долю попаданий политик на трассе ключей. По умолчанию используется
+// синтетическая трасса с распределением Ципфа, записанную трассу можно передать через -trace
+func BenchmarkPolicyHitRatio(b *testing.B) {
+	trace := loadTrace(b)
+	for _, policy := range policies {
+		b.Run(policy.String(), func(b *testing.B) {
+			var ratio float64
+			for i := 0; i < b.N; i++ {
+				ratio = replayTrace(trace, policy, 1000)
+			}
+			b.ReportMetric(ratio*100, "hit%")
+		})
+	}
+}
+
+func replayTrace(trace []string, policy Policy, capacity int) float64 {
+	c := NewCache(WithCapacity[string, struct{}](capacity), WithPolicy[string, struct{}](policy))
+	for _, key := range trace {
+		if _, ok := c.Get(key); !ok {
+			c.Set(key, struct{}{}, 0)
+		}
+	}
+	s := c.Stats()
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+func loadTrace(b *testing.B) []string {
+	if *traceFile == "" {
+		r := rand.New(rand.NewPCG(1, 2))
+		zipf := rand.NewZipf(r, 1.1, 1, 100000)
+		trace := make([]string, 200000)
+		for i := range trace {
+			trace[i] = strconv.FormatUint(zipf.Uint64(), 10)
+		}
+		return trace
+	}
+
+	f, err := os.Open(*traceFile)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Close()
+
+	var trace []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		trace = append(trace, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		b.Fatal(err)
+	}
+	return trace
+}
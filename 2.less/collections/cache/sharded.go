@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"context"
+	"hash/maphash"
+	"time"
+)
+
+// ShardedCache делит ключи между независимыми Cache, чтобы параллельные Get/Set не упирались в один мьютекс
+type ShardedCache[K comparable, V any] struct {
+	shards []*Cache[K, V]
+	seed   maphash.Seed
+}
+
+// NewShardedCache создает shardCount шардов с одинаковыми опциями. WithCapacity и WithMaxCost задают
+// общие ограничения, они делятся между шардами поровну (с округлением вверх)
+func NewShardedCache[K comparable, V any](shardCount int, options ...CacheOption[K, V]) *ShardedCache[K, V] {
+	if shardCount <= 0 {
+		shardCount = 1
+	}
+	sc := &ShardedCache[K, V]{shards: make([]*Cache[K, V], shardCount), seed: maphash.MakeSeed()}
+	for i := range sc.shards {
+		shard := NewCache(options...)
+		if shard.bounded() {
+			shard.capacity = (shard.capacity + shardCount - 1) / shardCount
+			shard.maxCost = (shard.maxCost + int64(shardCount) - 1) / int64(shardCount)
+			shard.policy = newPolicy[K, V](shard.policyKind, shard.capacity)
+		}
+		sc.shards[i] = shard
+	}
+	return sc
+}
+
+func (sc *ShardedCache[K, V]) shard(key K) *Cache[K, V] {
+	return sc.shards[maphash.Comparable(sc.seed, key)%uint64(len(sc.shards))]
+}
+
+func (sc *ShardedCache[K, V]) Get(key K) (V, bool) {
+	return sc.shard(key).Get(key)
+}
+
+func (sc *ShardedCache[K, V]) Set(key K, value V, ttl time.Duration) {
+	sc.shard(key).Set(key, value, ttl)
+}
+
+func (sc *ShardedCache[K, V]) GetOrLoad(ctx context.Context, key K, loader Loader[K, V]) (V, error) {
+	return sc.shard(key).GetOrLoad(ctx, key, loader)
+}
+
+func (sc *ShardedCache[K, V]) Delete(key K) {
+	sc.shard(key).Delete(key)
+}
+
+func (sc *ShardedCache[K, V]) Clear() {
+	for _, shard := range sc.shards {
+		shard.Clear()
+	}
+}
+
+func (sc *ShardedCache[K, V]) Close() {
+	for _, shard := range sc.shards {
+		shard.Close()
+	}
+}
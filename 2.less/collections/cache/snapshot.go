@@ -0,0 +1,133 @@
+package cache
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+)
+
+const snapshotVersion = 1
+
+var ErrSnapshotVersion = errors.New("unsupported snapshot version")
+
+type snapshotHeader struct {
+	Version int
+}
+
+// snapshotItem хранит оставшееся время жизни, а не абсолютное время, чтобы снимок не зависел от часов при рестарте
+type snapshotItem[K comparable, V any] struct {
+	Key   K
+	Value V
+	TTL   time.Duration // 0 - без ограничения по времени
+	Cost  int64
+}
+
+// Snapshot сохраняет содержимое кеша в gob. Для кеша с емкостью элементы пишутся в порядке политики вытеснения:
+// для LRU от самого свежего к самому старому.
+// Ключи и значения должны поддерживаться gob (экспортируемые поля)
+func (c *Cache[K, V]) Snapshot(w io.Writer) error {
+	return writeSnapshot(w, c.snapshotItems())
+}
+
+// Restore загружает элементы из снимка поверх текущего содержимого, сохраняя порядок LRU и оставшийся TTL.
+// Просроченные к моменту загрузки элементы пропускаются
+func (c *Cache[K, V]) Restore(r io.Reader) error {
+	items, err := readSnapshot[K, V](r)
+	if err != nil {
+		return err
+	}
+	c.restoreItems(items)
+	return nil
+}
+
+func (c *Cache[K, V]) snapshotItems() []snapshotItem[K, V] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	items := make([]snapshotItem[K, V], 0, len(c.items))
+	appendItem := func(item *CacheItem[K, V]) {
+		if c.withTTL && item.expired(now) {
+			return
+		}
+		// кеш без WithExpiration не учитывает TTL, поэтому элемент сохраняется как бессрочный
+		var ttl time.Duration
+		if c.withTTL && !item.expiration.IsZero() {
+			ttl = item.expiration.Sub(now)
+		}
+		items = append(items, snapshotItem[K, V]{Key: item.key, Value: item.value, TTL: ttl, Cost: item.cost})
+	}
+
+	if c.bounded() {
+		for item := range c.policy.all() {
+			appendItem(item)
+		}
+		return items
+	}
+	for _, item := range c.items {
+		appendItem(item)
+	}
+	return items
+}
+
+// restoreItems добавляет элементы с конца, чтобы самый свежий элемент снимка оказался в начале списка
+func (c *Cache[K, V]) restoreItems(items []snapshotItem[K, V]) {
+	for _, item := range slices.Backward(items) {
+		if item.TTL < 0 {
+			continue
+		}
+		c.set(item.Key, item.Value, item.Cost, item.TTL)
+	}
+}
+
+func writeSnapshot[K comparable, V any](w io.Writer, items []snapshotItem[K, V]) error {
+	enc := gob.NewEncoder(w)
+	if err := enc.Encode(snapshotHeader{Version: snapshotVersion}); err != nil {
+		return fmt.Errorf("encode snapshot header: %w", err)
+	}
+	if err := enc.Encode(items); err != nil {
+		return fmt.Errorf("encode snapshot items: %w", err)
+	}
+	return nil
+}
+
+func readSnapshot[K comparable, V any](r io.Reader) ([]snapshotItem[K, V], error) {
+	dec := gob.NewDecoder(r)
+	var header snapshotHeader
+	if err := dec.Decode(&header); err != nil {
+		return nil, fmt.Errorf("decode snapshot header: %w", err)
+	}
+	if header.Version != snapshotVersion {
+		return nil, fmt.Errorf("%w: %d", ErrSnapshotVersion, header.Version)
+	}
+	var items []snapshotItem[K, V]
+	if err := dec.Decode(&items); err != nil {
+		return nil, fmt.Errorf("decode snapshot items: %w", err)
+	}
+	return items, nil
+}
+
+func (sc *ShardedCache[K, V]) Snapshot(w io.Writer) error {
+	var items []snapshotItem[K, V]
+	for _, shard := range sc.shards {
+		items = append(items, shard.snapshotItems()...)
+	}
+	return writeSnapshot(w, items)
+}
+
+func (sc *ShardedCache[K, V]) Restore(r io.Reader) error {
+	items, err := readSnapshot[K, V](r)
+	if err != nil {
+		return err
+	}
+	for _, item := range slices.Backward(items) {
+		if item.TTL < 0 {
+			continue
+		}
+		sc.SetWithCost(item.Key, item.Value, item.Cost, item.TTL)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	src := NewCache(WithCapacity[string, int](3), WithExpiration[string, int]())
+	defer src.Close()
+	src.Set("a", 1, 0)
+	src.Set("b", 2, time.Hour)
+	src.Set("c", 3, 0)
+	src.Get("a") // порядок от свежего: a, c, b
+
+	var buf bytes.Buffer
+	if err := src.Snapshot(&buf); err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	dst := NewCache(WithCapacity[string, int](3), WithExpiration[string, int]())
+	defer dst.Close()
+	if err := dst.Restore(&buf); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+
+	var order []string
+	for item := range dst.policy.all() {
+		order = append(order, item.key)
+	}
+	if want := []string{"a", "c", "b"}; !slices.Equal(order, want) {
+		t.Errorf("LRU order = %v, expected %v", order, want)
+	}
+
+	ttl := time.Until(dst.items["b"].expiration)
+	if ttl <= 59*time.Minute || ttl > time.Hour {
+		t.Errorf("restored TTL = %v, expected about 1h", ttl)
+	}
+	if !dst.items["a"].expiration.IsZero() {
+		t.Errorf("restored item without TTL got expiration %v", dst.items["a"].expiration)
+	}
+}
+
+func TestSnapshotRestoreIgnoresTTLWithoutExpiration(t *testing.T) {
+	src := NewCache(WithCapacity[string, int](2))
+	src.Set("key", 1, time.Millisecond)
+	time.Sleep(2 * time.Millisecond)
+	if _, ok := src.Get("key"); !ok {
+		t.Fatal("Get() on source cache returned !ok")
+	}
+
+	var buf bytes.Buffer
+	if err := src.Snapshot(&buf); err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	dst := NewCache(WithCapacity[string, int](2))
+	if err := dst.Restore(&buf); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if value, ok := dst.Get("key"); !ok || value != 1 {
+		t.Errorf("Get() after Restore = %v, %v, expected 1, true", value, ok)
+	}
+}
+
+func TestRestoreUnsupportedVersion(t *testing.T) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(snapshotHeader{Version: snapshotVersion + 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewCache(WithCapacity[string, int](1))
+	if err := c.Restore(&buf); !errors.Is(err, ErrSnapshotVersion) {
+		t.Errorf("Restore() error = %v, expected %v", err, ErrSnapshotVersion)
+	}
+}
@@ -0,0 +1,46 @@
+package cache
+
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64 // вытеснены при достижении емкости
+	Expirations uint64 // удалены по истечении TTL
+	Size        int
+	Capacity    int   // 0 - емкость не ограничена
+	Cost        int64 // суммарная стоимость элементов
+	MaxCost     int64 // 0 - стоимость не ограничена
+}
+
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.RLock()
+	size := len(c.items)
+	cost := c.totalCost
+	c.mu.RUnlock()
+
+	return Stats{
+		Hits:        c.hits.Load(),
+		Misses:      c.misses.Load(),
+		Evictions:   c.evictions.Load(),
+		Expirations: c.expirations.Load(),
+		Size:        size,
+		Capacity:    c.capacity,
+		Cost:        cost,
+		MaxCost:     c.maxCost,
+	}
+}
+
+func (sc *ShardedCache[K, V]) Stats() Stats {
+	var total Stats
+	for _, shard := range sc.shards {
+		s := shard.Stats()
+		total.Hits += s.Hits
+		total.Misses += s.Misses
+		total.Evictions += s.Evictions
+		total.Expirations += s.Expirations
+		total.Size += s.Size
+		total.Capacity += s.Capacity
+		total.Cost += s.Cost
+		total.MaxCost += s.MaxCost
+	}
+	return total
+}
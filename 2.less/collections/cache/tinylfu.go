@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"container/list"
+	"hash/maphash"
+	"iter"
+)
+
+const (
+	segmentWindow = iota
+	segmentProbation
+	segmentProtected
+)
+
+// tinyLFUPolicy - W-TinyLFU: новые элементы попадают в маленькое LRU-окно (1% емкости), а вытесненный
+// из окна элемент попадает в основную SLRU-часть, только если по оценке sketch к нему обращались чаще,
+// чем к кандидату на вытеснение из основной части. Так редкие ключи при сканировании не вымывают популярные
+type tinyLFUPolicy[K comparable, V any] struct {
+	sketch       *countMinSketch[K]
+	window       *list.List
+	probation    *list.List
+	protected    *list.List
+	windowCap    int
+	mainCap      int
+	protectedCap int
+}
+
+func newTinyLFUPolicy[K comparable, V any](capacity int) *tinyLFUPolicy[K, V] {
+	p := &tinyLFUPolicy[K, V]{
+		sketch:    newCountMinSketch[K](capacity),
+		window:    list.New(),
+		probation: list.New(),
+		protected: list.New(),
+	}
+	p.resize(capacity)
+	return p
+}
+
+// resize пересчитывает размеры сегментов под емкость в элементах и переносит лишние элементы
+// из окна в основную часть и из protected в probation
+func (p *tinyLFUPolicy[K, V]) resize(capacity int) {
+	p.windowCap = max(1, capacity/100)
+	p.mainCap = max(0, capacity-p.windowCap)
+	p.protectedCap = p.mainCap * 8 / 10
+
+	for p.protected.Len() > p.protectedCap {
+		p.moveTo(back[K, V](p.protected), segmentProbation)
+	}
+	for p.window.Len() > p.windowCap && p.probation.Len()+p.protected.Len() < p.mainCap {
+		p.moveTo(back[K, V](p.window), segmentProbation)
+	}
+}
+
+func (p *tinyLFUPolicy[K, V]) segment(item *CacheItem[K, V]) *list.List {
+	switch item.segment {
+	case segmentProbation:
+		return p.probation
+	case segmentProtected:
+		return p.protected
+	default:
+		return p.window
+	}
+}
+
+func (p *tinyLFUPolicy[K, V]) moveTo(item *CacheItem[K, V], segment int) {
+	p.segment(item).Remove(item.element)
+	item.segment = segment
+	item.element = p.segment(item).PushFront(item)
+}
+
+func (p *tinyLFUPolicy[K, V]) add(item *CacheItem[K, V]) {
+	p.sketch.increment(item.key)
+	item.segment = segmentWindow
+	item.element = p.window.PushFront(item)
+
+	// пока основная часть не заполнена, элементы из окна переходят в нее без отбора
+	for p.window.Len() > p.windowCap && p.probation.Len()+p.protected.Len() < p.mainCap {
+		p.moveTo(back[K, V](p.window), segmentProbation)
+	}
+}
+
+func (p *tinyLFUPolicy[K, V]) touch(item *CacheItem[K, V]) {
+	p.sketch.increment(item.key)
+	switch item.segment {
+	case segmentWindow:
+		p.window.MoveToFront(item.element)
+	case segmentProbation:
+		p.moveTo(item, segmentProtected)
+		if p.protected.Len() > p.protectedCap {
+			p.moveTo(back[K, V](p.protected), segmentProbation)
+		}
+	case segmentProtected:
+		p.protected.MoveToFront(item.element)
+	}
+}
+
+func (p *tinyLFUPolicy[K, V]) remove(item *CacheItem[K, V]) {
+	p.segment(item).Remove(item.element)
+}
+
+// victim вызывается, когда кеш переполнен, поэтому текущее число элементов без одного - фактическая емкость.
+// При ограничении только по стоимости сегменты подстраиваются под нее, иначе окно разрастается
+// и элементы перестают проходить отбор по частоте
+func (p *tinyLFUPolicy[K, V]) victim() *CacheItem[K, V] {
+	p.resize(p.len() - 1)
+
+	mainVictim := back[K, V](p.probation)
+	if mainVictim == nil {
+		mainVictim = back[K, V](p.protected)
+	}
+	if p.window.Len() <= p.windowCap && mainVictim != nil {
+		return mainVictim
+	}
+
+	candidate := back[K, V](p.window)
+	if mainVictim == nil {
+		return candidate
+	}
+	if p.sketch.estimate(candidate.key) > p.sketch.estimate(mainVictim.key) {
+		p.moveTo(candidate, segmentProbation)
+		return mainVictim
+	}
+	return candidate
+}
+
+func (p *tinyLFUPolicy[K, V]) all() iter.Seq[*CacheItem[K, V]] {
+	return func(yield func(*CacheItem[K, V]) bool) {
+		for _, l := range []*list.List{p.protected, p.probation, p.window} {
+			for item := range listItems[K, V](l) {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *tinyLFUPolicy[K, V]) len() int {
+	return p.window.Len() + p.probation.Len() + p.protected.Len()
+}
+
+const (
+	sketchDepth   = 4
+	sketchMaxFreq = 15
+)
+
+// countMinSketch приблизительно считает частоту обращений к ключам. Счетчики периодически делятся пополам,
+// чтобы старая популярность со временем забывалась
+type countMinSketch[K comparable] struct {
+	seed      maphash.Seed
+	rows      [sketchDepth][]uint8
+	mask      uint64
+	additions int
+	resetAt   int
+}
+
+func newCountMinSketch[K comparable](capacity int) *countMinSketch[K] {
+	width := 16
+	for width < capacity {
+		width <<= 1
+	}
+	s := &countMinSketch[K]{seed: maphash.MakeSeed(), mask: uint64(width - 1), resetAt: 10 * max(capacity, 1)}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, width)
+	}
+	return s
+}
+
+// index - двойное хеширование: строки используют разные комбинации двух половин одного хеша
+func (s *countMinSketch[K]) index(hash uint64, row int) uint64 {
+	h1, h2 := hash, hash>>32|1
+	return (h1 + uint64(row)*h2) & s.mask
+}
+
+func (s *countMinSketch[K]) increment(key K) {
+	hash := maphash.Comparable(s.seed, key)
+	for row := range s.rows {
+		if i := s.index(hash, row); s.rows[row][i] < sketchMaxFreq {
+			s.rows[row][i]++
+		}
+	}
+
+	s.additions++
+	if s.additions >= s.resetAt {
+		for row := range s.rows {
+			for i := range s.rows[row] {
+				s.rows[row][i] /= 2
+			}
+		}
+		s.additions /= 2
+	}
+}
+
+func (s *countMinSketch[K]) estimate(key K) uint8 {
+	hash := maphash.Comparable(s.seed, key)
+	freq := uint8(sketchMaxFreq)
+	for row := range s.rows {
+		freq = min(freq, s.rows[row][s.index(hash, row)])
+	}
+	return freq
+}
@@ -0,0 +1,70 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+// FuzzListOps сверяет List с моделью на срезе: старшие два бита байта выбирают операцию, младшие - позицию
+func FuzzListOps(f *testing.F) {
+	f.Add([]byte{0, 0x40, 0x81, 0xc2, 0x03})
+	f.Add([]byte{0x40, 0x40, 0x40, 0xc0, 0xc0})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		l := NewList[int]()
+		var elements []*Element[int]
+		var model []int
+
+		for i, op := range ops {
+			pos := 0
+			if len(model) > 0 {
+				pos = int(op&0x3f) % len(model)
+			}
+			switch op >> 6 {
+			case 0:
+				elements = append(elements, l.PushBack(i))
+				model = append(model, i)
+			case 1:
+				elements = slices.Insert(elements, 0, l.PushFront(i))
+				model = slices.Insert(model, 0, i)
+			case 2:
+				if len(model) == 0 {
+					continue
+				}
+				if op&1 == 0 {
+					elements = slices.Insert(elements, pos, l.InsertBefore(i, elements[pos]))
+					model = slices.Insert(model, pos, i)
+				} else {
+					elements = slices.Insert(elements, pos+1, l.InsertAfter(i, elements[pos]))
+					model = slices.Insert(model, pos+1, i)
+				}
+			case 3:
+				if len(model) == 0 {
+					continue
+				}
+				if op&1 == 0 {
+					l.Remove(elements[pos])
+					elements = slices.Delete(elements, pos, pos+1)
+					model = slices.Delete(model, pos, pos+1)
+				} else {
+					l.MoveToFront(elements[pos])
+					e, v := elements[pos], model[pos]
+					elements = slices.Insert(slices.Delete(elements, pos, pos+1), 0, e)
+					model = slices.Insert(slices.Delete(model, pos, pos+1), 0, v)
+				}
+			}
+
+			if got := slices.Collect(l.All()); !slices.Equal(got, model) {
+				t.Fatalf("op %d: All() = %v, expected %v", i, got, model)
+			}
+			reversed := slices.Clone(model)
+			slices.Reverse(reversed)
+			if got := slices.Collect(l.Backward()); !slices.Equal(got, reversed) {
+				t.Fatalf("op %d: Backward() = %v, expected %v", i, got, reversed)
+			}
+			if l.Len() != len(model) {
+				t.Fatalf("op %d: Len() = %d, expected %d", i, l.Len(), len(model))
+			}
+		}
+	})
+}
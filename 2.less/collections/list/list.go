@@ -0,0 +1,134 @@
+package list
+
+import "iter"
+
+type Element[T any] struct {
+	Value T
+	prev  *Element[T]
+	next  *Element[T]
+	list  *List[T]
+}
+
+func (e *Element[T]) Next() *Element[T] {
+	return e.next
+}
+
+func (e *Element[T]) Prev() *Element[T] {
+	return e.prev
+}
+
+// List - двусвязный список с указателями на голову и хвост: вставка и удаление за O(1)
+type List[T any] struct {
+	head *Element[T]
+	tail *Element[T]
+	len  int
+}
+
+func NewList[T any]() *List[T] {
+	return &List[T]{}
+}
+
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+func (l *List[T]) Front() *Element[T] {
+	return l.head
+}
+
+func (l *List[T]) Back() *Element[T] {
+	return l.tail
+}
+
+func (l *List[T]) PushFront(val T) *Element[T] {
+	return l.insert(&Element[T]{Value: val}, nil, l.head)
+}
+
+func (l *List[T]) PushBack(val T) *Element[T] {
+	return l.insert(&Element[T]{Value: val}, l.tail, nil)
+}
+
+// InsertBefore вставляет значение перед mark. Если mark не из этого списка, список не меняется и возвращается nil
+func (l *List[T]) InsertBefore(val T, mark *Element[T]) *Element[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: val}, mark.prev, mark)
+}
+
+// InsertAfter вставляет значение после mark. Если mark не из этого списка, список не меняется и возвращается nil
+func (l *List[T]) InsertAfter(val T, mark *Element[T]) *Element[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: val}, mark, mark.next)
+}
+
+func (l *List[T]) Remove(e *Element[T]) T {
+	if e.list == l {
+		l.unlink(e)
+	}
+	return e.Value
+}
+
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	if e.list != l || l.head == e {
+		return
+	}
+	l.unlink(e)
+	l.insert(e, nil, l.head)
+}
+
+// All перебирает значения от головы к хвосту
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.head; e != nil; e = e.next {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward перебирает значения от хвоста к голове
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.tail; e != nil; e = e.prev {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// insert вставляет e между prev и next (nil означает начало или конец списка)
+func (l *List[T]) insert(e, prev, next *Element[T]) *Element[T] {
+	e.prev, e.next, e.list = prev, next, l
+	if prev != nil {
+		prev.next = e
+	} else {
+		l.head = e
+	}
+	if next != nil {
+		next.prev = e
+	} else {
+		l.tail = e
+	}
+	l.len++
+	return e
+}
+
+func (l *List[T]) unlink(e *Element[T]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		l.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		l.tail = e.prev
+	}
+	e.prev, e.next, e.list = nil, nil, nil
+	l.len--
+}
@@ -0,0 +1,64 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func checkList(t *testing.T, l *List[int], expected []int) {
+	t.Helper()
+	if got := slices.Collect(l.All()); !slices.Equal(got, expected) {
+		t.Errorf("All() = %v, expected %v", got, expected)
+	}
+	reversed := slices.Clone(expected)
+	slices.Reverse(reversed)
+	if got := slices.Collect(l.Backward()); !slices.Equal(got, reversed) {
+		t.Errorf("Backward() = %v, expected %v", got, reversed)
+	}
+	if l.Len() != len(expected) {
+		t.Errorf("Len() = %d, expected %d", l.Len(), len(expected))
+	}
+}
+
+func TestListPushAndInsert(t *testing.T) {
+	l := NewList[int]()
+	checkList(t, l, nil)
+
+	two := l.PushBack(2)
+	l.PushBack(4)
+	l.PushFront(1)
+	l.InsertAfter(3, two)
+	l.InsertBefore(0, l.Front())
+	l.InsertAfter(5, l.Back())
+
+	checkList(t, l, []int{0, 1, 2, 3, 4, 5})
+}
+
+func TestListRemoveAndMove(t *testing.T) {
+	l := NewList[int]()
+	elements := make([]*Element[int], 5)
+	for i := range elements {
+		elements[i] = l.PushBack(i)
+	}
+
+	if val := l.Remove(elements[0]); val != 0 {
+		t.Errorf("Remove() = %d, expected 0", val)
+	}
+	l.Remove(elements[4])
+	l.Remove(elements[2])
+	checkList(t, l, []int{1, 3})
+
+	l.MoveToFront(elements[3])
+	checkList(t, l, []int{3, 1})
+
+	// элементы, уже удаленные или из другого списка, игнорируются
+	l.Remove(elements[2])
+	l.MoveToFront(elements[2])
+	other := NewList[int]()
+	foreign := other.PushBack(9)
+	l.MoveToFront(foreign)
+	if l.InsertBefore(7, foreign) != nil {
+		t.Error("InsertBefore() accepted an element of another list")
+	}
+	checkList(t, l, []int{3, 1})
+}
@@ -0,0 +1,190 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	maxLevel    = 32
+	levelFactor = 4 // на каждый следующий уровень попадает примерно каждый четвертый узел
+)
+
+type node[K, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+}
+
+// Map - упорядоченный по ключам словарь на списке с пропусками: поиск, вставка и удаление за O(log n)
+// в среднем, обход ключей по возрастанию без сортировки. Не потокобезопасен
+type Map[K, V any] struct {
+	head    *node[K, V]
+	level   int
+	len     int
+	compare func(a, b K) int
+}
+
+func New[K cmp.Ordered, V any]() *Map[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc создает словарь с собственным сравнением ключей, например для time.Time:
+// NewFunc[time.Time, Message](time.Time.Compare)
+func NewFunc[K, V any](compare func(a, b K) int) *Map[K, V] {
+	return &Map[K, V]{
+		head:    &node[K, V]{next: make([]*node[K, V], maxLevel)},
+		level:   1,
+		compare: compare,
+	}
+}
+
+func (m *Map[K, V]) Len() int {
+	return m.len
+}
+
+// findLess заполняет update последними узлами с ключом меньше key на каждом уровне
+// и возвращает такой узел нижнего уровня
+func (m *Map[K, V]) findLess(key K, update []*node[K, V]) *node[K, V] {
+	x := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && m.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x
+}
+
+func (m *Map[K, V]) Set(key K, value V) {
+	var update [maxLevel]*node[K, V]
+	x := m.findLess(key, update[:])
+	if next := x.next[0]; next != nil && m.compare(next.key, key) == 0 {
+		next.value = value
+		return
+	}
+
+	level := randomLevel()
+	if level > m.level {
+		for i := m.level; i < level; i++ {
+			update[i] = m.head
+		}
+		m.level = level
+	}
+	n := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	m.len++
+}
+
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	if next := m.findLess(key, nil).next[0]; next != nil && m.compare(next.key, key) == 0 {
+		return next.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *Map[K, V]) Delete(key K) bool {
+	var update [maxLevel]*node[K, V]
+	n := m.findLess(key, update[:]).next[0]
+	if n == nil || m.compare(n.key, key) != 0 {
+		return false
+	}
+	for i := range n.next {
+		update[i].next[i] = n.next[i]
+	}
+	for m.level > 1 && m.head.next[m.level-1] == nil {
+		m.level--
+	}
+	m.len--
+	return true
+}
+
+// Floor возвращает наибольший ключ, не превышающий key
+func (m *Map[K, V]) Floor(key K) (K, V, bool) {
+	x := m.findLess(key, nil)
+	if next := x.next[0]; next != nil && m.compare(next.key, key) == 0 {
+		return next.key, next.value, true
+	}
+	if x == m.head {
+		return m.none()
+	}
+	return x.key, x.value, true
+}
+
+// Ceiling возвращает наименьший ключ, не меньший key
+func (m *Map[K, V]) Ceiling(key K) (K, V, bool) {
+	if next := m.findLess(key, nil).next[0]; next != nil {
+		return next.key, next.value, true
+	}
+	return m.none()
+}
+
+func (m *Map[K, V]) Min() (K, V, bool) {
+	if first := m.head.next[0]; first != nil {
+		return first.key, first.value, true
+	}
+	return m.none()
+}
+
+func (m *Map[K, V]) Max() (K, V, bool) {
+	x := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			x = x.next[i]
+		}
+	}
+	if x == m.head {
+		return m.none()
+	}
+	return x.key, x.value, true
+}
+
+// All перебирает пары по возрастанию ключей. Начало обхода ищется при каждом запуске перебора,
+// поэтому последовательность можно переиспользовать после изменения карты
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return m.from(func() *node[K, V] { return m.head.next[0] }, nil)
+}
+
+// Range перебирает пары с ключами из полуинтервала [from, to) по возрастанию
+func (m *Map[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	start := func() *node[K, V] { return m.findLess(from, nil).next[0] }
+	return m.from(start, func(key K) bool {
+		return m.compare(key, to) < 0
+	})
+}
+
+func (m *Map[K, V]) from(start func() *node[K, V], inRange func(key K) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := start(); x != nil; x = x.next[0] {
+			if inRange != nil && !inRange(x.key) {
+				return
+			}
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Map[K, V]) none() (K, V, bool) {
+	var (
+		key   K
+		value V
+	)
+	return key, value, false
+}
+
+func randomLevel() int {
+	level := 1
+	for level < maxLevel && rand.IntN(levelFactor) == 0 {
+		level++
+	}
+	return level
+}
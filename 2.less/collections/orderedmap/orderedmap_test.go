@@ -0,0 +1,138 @@
+package orderedmap
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFloorCeiling(t *testing.T) {
+	m := New[int, string]()
+	for _, key := range []int{50, 10, 30, 20, 40} {
+		m.Set(key, "v")
+	}
+
+	tests := []struct {
+		key            int
+		floor, ceiling int
+		hasFloor       bool
+		hasCeiling     bool
+	}{
+		{key: 5, ceiling: 10, hasCeiling: true},
+		{key: 10, floor: 10, ceiling: 10, hasFloor: true, hasCeiling: true},
+		{key: 25, floor: 20, ceiling: 30, hasFloor: true, hasCeiling: true},
+		{key: 50, floor: 50, ceiling: 50, hasFloor: true, hasCeiling: true},
+		{key: 55, floor: 50, hasFloor: true},
+	}
+	for _, tt := range tests {
+		floor, _, ok := m.Floor(tt.key)
+		if ok != tt.hasFloor || floor != tt.floor {
+			t.Errorf("Floor(%d) = %d, %v, expected %d, %v", tt.key, floor, ok, tt.floor, tt.hasFloor)
+		}
+		ceiling, _, ok := m.Ceiling(tt.key)
+		if ok != tt.hasCeiling || ceiling != tt.ceiling {
+			t.Errorf("Ceiling(%d) = %d, %v, expected %d, %v", tt.key, ceiling, ok, tt.ceiling, tt.hasCeiling)
+		}
+	}
+
+	if key, _, _ := m.Min(); key != 10 {
+		t.Errorf("Min() = %d, expected 10", key)
+	}
+	if key, _, _ := m.Max(); key != 50 {
+		t.Errorf("Max() = %d, expected 50", key)
+	}
+}
+
+func TestTimeOrderedHistory(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	history := NewFunc[time.Time, string](time.Time.Compare)
+	for i, text := range []string{"c", "a", "d", "b"} {
+		history.Set(start.Add(time.Duration([]int{3, 1, 4, 2}[i])*time.Minute), text)
+	}
+
+	var texts []string
+	for _, text := range history.Range(start.Add(2*time.Minute), start.Add(4*time.Minute)) {
+		texts = append(texts, text)
+	}
+	if !slices.Equal(texts, []string{"b", "c"}) {
+		t.Errorf("Range() = %v, expected [b c]", texts)
+	}
+}
+
+func TestSeqAfterMutation(t *testing.T) {
+	m := New[int, string]()
+	for _, key := range []int{1, 5, 7} {
+		m.Set(key, "v")
+	}
+	all := m.All()
+	r := m.Range(0, 10)
+
+	m.Delete(1)
+	m.Delete(5)
+	m.Set(3, "v")
+
+	// последовательности, полученные до изменений, видят текущее состояние карты
+	for name, seq := range map[string]iter.Seq2[int, string]{"All": all, "Range": r} {
+		var keys []int
+		for key := range seq {
+			keys = append(keys, key)
+		}
+		if !slices.Equal(keys, []int{3, 7}) {
+			t.Errorf("%s() = %v, expected [3 7]", name, keys)
+		}
+	}
+}
+
+// FuzzOrderedMap сверяет Map с обычным map и сортировкой ключей
+func FuzzOrderedMap(f *testing.F) {
+	f.Add([]byte{5, 3, 9, 0x83, 7, 0x85, 3})
+	f.Add([]byte{1, 1, 1, 0x81, 0x81})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		m := New[byte, int]()
+		model := make(map[byte]int)
+		for i, op := range ops {
+			key := op & 0x7f
+			if op&0x80 == 0 {
+				m.Set(key, i)
+				model[key] = i
+			} else {
+				_, exists := model[key]
+				if deleted := m.Delete(key); deleted != exists {
+					t.Fatalf("Delete(%d) = %v, expected %v", key, deleted, exists)
+				}
+				delete(model, key)
+			}
+		}
+
+		keys := slices.Sorted(maps.Keys(model))
+		var got []byte
+		for key, value := range m.All() {
+			if model[key] != value {
+				t.Fatalf("value for %d = %d, expected %d", key, value, model[key])
+			}
+			got = append(got, key)
+		}
+		if !slices.Equal(got, keys) || m.Len() != len(keys) {
+			t.Fatalf("All() keys = %v, Len() = %d, expected %v", got, m.Len(), keys)
+		}
+
+		for probe := byte(0); probe < 0x80; probe++ {
+			i, found := slices.BinarySearch(keys, probe)
+			ceiling, _, ok := m.Ceiling(probe)
+			if ok != (i < len(keys)) || ok && ceiling != keys[i] {
+				t.Fatalf("Ceiling(%d) = %d, %v", probe, ceiling, ok)
+			}
+			floorIdx := i - 1
+			if found {
+				floorIdx = i
+			}
+			floor, _, ok := m.Floor(probe)
+			if ok != (floorIdx >= 0) || ok && floor != keys[floorIdx] {
+				t.Fatalf("Floor(%d) = %d, %v", probe, floor, ok)
+			}
+		}
+	})
+}
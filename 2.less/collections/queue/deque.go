@@ -0,0 +1,81 @@
+package queue
+
+// Deque - двусторонняя очередь на кольцевом буфере: добавление и извлечение с обоих концов
+// за амортизированное O(1)
+type Deque[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+func (d *Deque[T]) PushFront(value T) {
+	d.growIfFull()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = value
+	d.len++
+}
+
+func (d *Deque[T]) PushBack(value T) {
+	d.growIfFull()
+	d.buf[(d.head+d.len)%len(d.buf)] = value
+	d.len++
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.len == 0 {
+		return zero, false
+	}
+	value := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.len--
+	return value, true
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.len == 0 {
+		return zero, false
+	}
+	i := (d.head + d.len - 1) % len(d.buf)
+	value := d.buf[i]
+	d.buf[i] = zero
+	d.len--
+	return value, true
+}
+
+func (d *Deque[T]) Front() (T, bool) {
+	if d.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+func (d *Deque[T]) Back() (T, bool) {
+	if d.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[(d.head+d.len-1)%len(d.buf)], true
+}
+
+func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+func (d *Deque[T]) growIfFull() {
+	if d.len < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(2*len(d.buf), 4))
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,56 @@
+package queue
+
+import "testing"
+
+// FuzzQueueFIFO сверяет Queue, MPMCQueue и Deque с моделью на срезе. Старший бит байта - извлечение,
+// остальные биты - добавляемое значение
+func FuzzQueueFIFO(f *testing.F) {
+	f.Add([]byte{1, 2, 3, 0x80, 4, 0x80, 0x80, 0x80, 0x80})
+	f.Add([]byte{0x80, 5, 0x80})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		const capacity = 8
+		q := NewBoundedQueue[byte](capacity)
+		mpmc := NewMPMCQueue[byte](capacity)
+		d := NewDeque[byte]()
+		var model []byte
+
+		for i, op := range ops {
+			if op&0x80 == 0 {
+				full := len(model) == capacity
+				if err := q.Enqueue(op); (err != nil) != full {
+					t.Fatalf("op %d: Queue.Enqueue() error = %v, model len %d", i, err, len(model))
+				}
+				if err := mpmc.Enqueue(op); (err != nil) != full {
+					t.Fatalf("op %d: MPMCQueue.Enqueue() error = %v, model len %d", i, err, len(model))
+				}
+				if !full {
+					d.PushBack(op)
+					model = append(model, op)
+				}
+				continue
+			}
+
+			value, err := q.Dequeue()
+			mpmcValue, mpmcErr := mpmc.Dequeue()
+			dequeValue, dequeOk := d.PopFront()
+			if len(model) == 0 {
+				if err == nil || mpmcErr == nil || dequeOk {
+					t.Fatalf("op %d: dequeue from empty queue succeeded", i)
+				}
+				continue
+			}
+			expected := model[0]
+			model = model[1:]
+			if err != nil || mpmcErr != nil || !dequeOk {
+				t.Fatalf("op %d: dequeue failed: %v, %v, %v", i, err, mpmcErr, dequeOk)
+			}
+			if value != expected || mpmcValue != expected || dequeValue != expected {
+				t.Fatalf("op %d: dequeued %d, %d, %d, expected %d", i, value, mpmcValue, dequeValue, expected)
+			}
+		}
+		if q.Len() != len(model) || mpmc.Len() != len(model) || d.Len() != len(model) {
+			t.Errorf("Len() = %d, %d, %d, expected %d", q.Len(), mpmc.Len(), d.Len(), len(model))
+		}
+	})
+}
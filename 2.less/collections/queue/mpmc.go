@@ -0,0 +1,87 @@
+package queue
+
+import "sync/atomic"
+
+const cacheLineSize = 64
+
+type mpmcCell[T any] struct {
+	sequence atomic.Uint64
+	value    T
+}
+
+// MPMCQueue - ограниченная lock-free очередь для нескольких производителей и потребителей (алгоритм Вьюкова).
+// У каждой ячейки есть счетчик sequence: по нему производитель понимает, что ячейка свободна, а потребитель -
+// что в нее уже записано значение. Позиции чтения и записи разнесены по разным кеш-линиям
+type MPMCQueue[T any] struct {
+	_          [cacheLineSize]byte
+	enqueuePos atomic.Uint64
+	_          [cacheLineSize - 8]byte
+	dequeuePos atomic.Uint64
+	_          [cacheLineSize - 8]byte
+	mask       uint64
+	cells      []mpmcCell[T]
+}
+
+// NewMPMCQueue создает очередь емкостью capacity, округленной вверх до степени двойки
+func NewMPMCQueue[T any](capacity int) *MPMCQueue[T] {
+	size := 2
+	for size < capacity {
+		size <<= 1
+	}
+	q := &MPMCQueue[T]{mask: uint64(size - 1), cells: make([]mpmcCell[T], size)}
+	for i := range q.cells {
+		q.cells[i].sequence.Store(uint64(i))
+	}
+	return q
+}
+
+func (q *MPMCQueue[T]) Enqueue(value T) error {
+	pos := q.enqueuePos.Load()
+	for {
+		cell := &q.cells[pos&q.mask]
+		diff := int64(cell.sequence.Load() - pos)
+		switch {
+		case diff == 0:
+			if q.enqueuePos.CompareAndSwap(pos, pos+1) {
+				cell.value = value
+				cell.sequence.Store(pos + 1)
+				return nil
+			}
+			pos = q.enqueuePos.Load()
+		case diff < 0:
+			return ErrFullQueue
+		default:
+			pos = q.enqueuePos.Load()
+		}
+	}
+}
+
+func (q *MPMCQueue[T]) Dequeue() (T, error) {
+	pos := q.dequeuePos.Load()
+	for {
+		cell := &q.cells[pos&q.mask]
+		diff := int64(cell.sequence.Load() - (pos + 1))
+		switch {
+		case diff == 0:
+			if q.dequeuePos.CompareAndSwap(pos, pos+1) {
+				value := cell.value
+				var zero T
+				cell.value = zero
+				cell.sequence.Store(pos + q.mask + 1)
+				return value, nil
+			}
+			pos = q.dequeuePos.Load()
+		case diff < 0:
+			var zero T
+			return zero, ErrEmptyQueue
+		default:
+			pos = q.dequeuePos.Load()
+		}
+	}
+}
+
+// Len - приблизительное число элементов: при конкурентной работе значение может сразу устареть
+func (q *MPMCQueue[T]) Len() int {
+	n := int64(q.enqueuePos.Load() - q.dequeuePos.Load())
+	return int(min(max(n, 0), int64(len(q.cells))))
+}
@@ -0,0 +1,137 @@
+package queue
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestMPMCQueueSequential(t *testing.T) {
+	q := NewMPMCQueue[int](3) // округляется до 4
+	for i := 0; i < 4; i++ {
+		if err := q.Enqueue(i); err != nil {
+			t.Fatalf("Enqueue(%d) error = %v", i, err)
+		}
+	}
+	if err := q.Enqueue(4); !errors.Is(err, ErrFullQueue) {
+		t.Errorf("Enqueue() into full queue error = %v, expected %v", err, ErrFullQueue)
+	}
+	for i := 0; i < 4; i++ {
+		if value, err := q.Dequeue(); err != nil || value != i {
+			t.Fatalf("Dequeue() = %d, %v, expected %d", value, err, i)
+		}
+	}
+	if _, err := q.Dequeue(); !errors.Is(err, ErrEmptyQueue) {
+		t.Errorf("Dequeue() from empty queue error = %v, expected %v", err, ErrEmptyQueue)
+	}
+}
+
+func TestMPMCQueueConcurrent(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 5000
+	q := NewMPMCQueue[int](64)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				for q.Enqueue(p*perProducer+i) != nil {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	seen := make([][]int, consumers)
+	var consumed sync.WaitGroup
+	remaining := make(chan struct{}, producers*perProducer)
+	for i := 0; i < producers*perProducer; i++ {
+		remaining <- struct{}{}
+	}
+	close(remaining)
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for range remaining {
+				for {
+					value, err := q.Dequeue()
+					if err == nil {
+						seen[c] = append(seen[c], value)
+						break
+					}
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	consumed.Wait()
+
+	counts := make([]int, producers*perProducer)
+	for _, values := range seen {
+		last := make(map[int]int)
+		for _, value := range values {
+			counts[value]++
+			// значения одного производителя потребитель получает в порядке добавления
+			producer := value / perProducer
+			if prev, ok := last[producer]; ok && prev > value {
+				t.Fatalf("value %d dequeued after %d", value, prev)
+			}
+			last[producer] = value
+		}
+	}
+	for value, count := range counts {
+		if count != 1 {
+			t.Fatalf("value %d dequeued %d times", value, count)
+		}
+	}
+}
+
+const benchQueueSize = 1024
+
+func BenchmarkMPMCQueue(b *testing.B) {
+	q := NewMPMCQueue[int](benchQueueSize)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			for q.Enqueue(1) != nil {
+				runtime.Gosched()
+			}
+			for {
+				if _, err := q.Dequeue(); err == nil {
+					break
+				}
+				runtime.Gosched()
+			}
+		}
+	})
+}
+
+func BenchmarkMutexQueue(b *testing.B) {
+	q := NewBoundedQueue[int](benchQueueSize)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			for q.Enqueue(1) != nil {
+				runtime.Gosched()
+			}
+			for {
+				if _, err := q.Dequeue(); err == nil {
+					break
+				}
+				runtime.Gosched()
+			}
+		}
+	})
+}
+
+func BenchmarkChannel(b *testing.B) {
+	ch := make(chan int, benchQueueSize)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			ch <- 1
+			<-ch
+		}
+	})
+}
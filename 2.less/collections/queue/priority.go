@@ -0,0 +1,97 @@
+package queue
+
+import "container/heap"
+
+// PQItem - дескриптор элемента в PriorityQueue, через него значение можно изменить или удалить
+type PQItem[T any] struct {
+	Value T
+	index int // -1, если элемент уже извлечен из очереди
+}
+
+// PriorityQueue - очередь с приоритетом на двоичной куче: первым извлекается элемент, для которого
+// less(a, b) истинно относительно остальных
+type PriorityQueue[T any] struct {
+	heap pqHeap[T]
+}
+
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{heap: pqHeap[T]{less: less}}
+}
+
+func (pq *PriorityQueue[T]) Push(value T) *PQItem[T] {
+	item := &PQItem[T]{Value: value}
+	heap.Push(&pq.heap, item)
+	return item
+}
+
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if pq.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&pq.heap).(*PQItem[T]).Value, true
+}
+
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if pq.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.heap.items[0].Value, true
+}
+
+// Update меняет значение элемента и восстанавливает порядок. Возвращает false, если элемента уже нет в очереди
+func (pq *PriorityQueue[T]) Update(item *PQItem[T], value T) bool {
+	if !pq.contains(item) {
+		return false
+	}
+	item.Value = value
+	heap.Fix(&pq.heap, item.index)
+	return true
+}
+
+// Remove удаляет элемент из любого места очереди. Возвращает false, если элемента уже нет в очереди
+func (pq *PriorityQueue[T]) Remove(item *PQItem[T]) bool {
+	if !pq.contains(item) {
+		return false
+	}
+	heap.Remove(&pq.heap, item.index)
+	return true
+}
+
+func (pq *PriorityQueue[T]) Len() int {
+	return pq.heap.Len()
+}
+
+func (pq *PriorityQueue[T]) contains(item *PQItem[T]) bool {
+	return item.index >= 0 && item.index < pq.heap.Len() && pq.heap.items[item.index] == item
+}
+
+type pqHeap[T any] struct {
+	items []*PQItem[T]
+	less  func(a, b T) bool
+}
+
+func (h pqHeap[T]) Len() int           { return len(h.items) }
+func (h pqHeap[T]) Less(i, j int) bool { return h.less(h.items[i].Value, h.items[j].Value) }
+
+func (h pqHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *pqHeap[T]) Push(x any) {
+	item := x.(*PQItem[T])
+	item.index = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *pqHeap[T]) Pop() any {
+	old := h.items
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	item.index = -1
+	h.items = old[:len(old)-1]
+	return item
+}
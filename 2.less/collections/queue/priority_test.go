@@ -0,0 +1,83 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+)
+
+type task struct {
+	name     string
+	priority int
+}
+
+func TestPriorityQueue(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b task) bool { return a.priority > b.priority })
+	pq.Push(task{"low", 1})
+	mid := pq.Push(task{"mid", 5})
+	pq.Push(task{"high", 10})
+	removed := pq.Push(task{"removed", 7})
+
+	if !pq.Update(mid, task{"mid", 20}) {
+		t.Fatal("Update() of queued item returned false")
+	}
+	if !pq.Remove(removed) {
+		t.Fatal("Remove() of queued item returned false")
+	}
+	if pq.Remove(removed) || pq.Update(removed, task{}) {
+		t.Error("Remove() or Update() accepted already removed item")
+	}
+	if top, ok := pq.Peek(); !ok || top.name != "mid" {
+		t.Errorf("Peek() = %v, %v, expected mid", top, ok)
+	}
+
+	var order []string
+	for pq.Len() > 0 {
+		item, _ := pq.Pop()
+		order = append(order, item.name)
+	}
+	if expected := []string{"mid", "high", "low"}; !slices.Equal(order, expected) {
+		t.Errorf("Pop() order = %v, expected %v", order, expected)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Error("Pop() from empty queue returned ok")
+	}
+	if mid.index != -1 {
+		t.Errorf("popped item index = %d, expected -1", mid.index)
+	}
+}
+
+func TestDeque(t *testing.T) {
+	d := NewDeque[int]()
+	// чередуем концы, чтобы буфер рос при сдвинутой голове
+	for i := 1; i <= 10; i++ {
+		if i%2 == 0 {
+			d.PushBack(i)
+		} else {
+			d.PushFront(i)
+		}
+	}
+
+	var fromFront []int
+	for d.Len() > 5 {
+		value, _ := d.PopFront()
+		fromFront = append(fromFront, value)
+	}
+	var fromBack []int
+	for d.Len() > 0 {
+		value, _ := d.PopBack()
+		fromBack = append(fromBack, value)
+	}
+
+	if expected := []int{9, 7, 5, 3, 1}; !slices.Equal(fromFront, expected) {
+		t.Errorf("PopFront() order = %v, expected %v", fromFront, expected)
+	}
+	if expected := []int{10, 8, 6, 4, 2}; !slices.Equal(fromBack, expected) {
+		t.Errorf("PopBack() order = %v, expected %v", fromBack, expected)
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Error("PopBack() from empty deque returned ok")
+	}
+	if _, ok := d.Front(); ok {
+		t.Error("Front() of empty deque returned ok")
+	}
+}
@@ -0,0 +1,161 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	ErrEmptyQueue = errors.New("empty queue")
+	ErrFullQueue  = errors.New("full queue")
+)
+
+// Queue - FIFO-очередь на кольцевом буфере. Буфер растет удвоением, а освободившиеся ячейки обнуляются,
+// поэтому Dequeue не удерживает старые значения, как при срезе s[1:]. Очередь потокобезопасна
+type Queue[T any] struct {
+	mu       sync.Mutex
+	buf      []T
+	head     int
+	len      int
+	capacity int // 0 - без ограничения
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{}
+}
+
+// NewBoundedQueue создает очередь не больше capacity элементов: Offer и Enqueue в полную очередь
+// не добавляют элемент, а Put ждет освобождения места
+func NewBoundedQueue[T any](capacity int) *Queue[T] {
+	return &Queue[T]{capacity: max(capacity, 1)}
+}
+
+func (q *Queue[T]) Enqueue(value T) error {
+	if !q.Offer(value) {
+		return ErrFullQueue
+	}
+	return nil
+}
+
+func (q *Queue[T]) Dequeue() (T, error) {
+	value, ok := q.Poll()
+	if !ok {
+		return value, ErrEmptyQueue
+	}
+	return value, nil
+}
+
+// Offer добавляет элемент и возвращает false, если ограниченная очередь заполнена
+func (q *Queue[T]) Offer(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.push(value)
+}
+
+// Poll забирает первый элемент и возвращает false, если очередь пуста
+func (q *Queue[T]) Poll() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.pop()
+}
+
+// Put добавляет элемент, дожидаясь свободного места в ограниченной очереди или отмены ctx
+func (q *Queue[T]) Put(ctx context.Context, value T) error {
+	for {
+		q.mu.Lock()
+		if q.push(value) {
+			q.mu.Unlock()
+			return nil
+		}
+		if q.notFull == nil {
+			q.notFull = make(chan struct{})
+		}
+		wait := q.notFull
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Take забирает первый элемент, дожидаясь его появления или отмены ctx
+func (q *Queue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		if value, ok := q.pop(); ok {
+			q.mu.Unlock()
+			return value, nil
+		}
+		if q.notEmpty == nil {
+			q.notEmpty = make(chan struct{})
+		}
+		wait := q.notEmpty
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+func (q *Queue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.len
+}
+
+// push и pop вызываются под q.mu
+func (q *Queue[T]) push(value T) bool {
+	if q.capacity > 0 && q.len == q.capacity {
+		return false
+	}
+	if q.len == len(q.buf) {
+		q.grow()
+	}
+	q.buf[(q.head+q.len)%len(q.buf)] = value
+	q.len++
+	q.notEmpty = broadcast(q.notEmpty)
+	return true
+}
+
+func (q *Queue[T]) pop() (T, bool) {
+	var zero T
+	if q.len == 0 {
+		return zero, false
+	}
+	value := q.buf[q.head]
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.len--
+	q.notFull = broadcast(q.notFull)
+	return value, true
+}
+
+func (q *Queue[T]) grow() {
+	size := max(2*len(q.buf), 4)
+	if q.capacity > 0 {
+		size = min(size, q.capacity)
+	}
+	buf := make([]T, size)
+	n := copy(buf, q.buf[q.head:])
+	copy(buf[n:], q.buf[:q.head])
+	q.buf = buf
+	q.head = 0
+}
+
+// broadcast будит всех, кто ждет на канале. Канал создается только при появлении ожидающих
+func broadcast(wait chan struct{}) chan struct{} {
+	if wait != nil {
+		close(wait)
+	}
+	return nil
+}
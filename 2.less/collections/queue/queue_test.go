@@ -0,0 +1,112 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueueFIFOWithWrapAround(t *testing.T) {
+	q := NewQueue[int]()
+	next, expected := 0, 0
+	// чередуем добавление и извлечение, чтобы голова буфера сдвигалась и буфер рос при переносе
+	for round := 1; round <= 10; round++ {
+		for i := 0; i < round*3; i++ {
+			if err := q.Enqueue(next); err != nil {
+				t.Fatalf("Enqueue() error = %v", err)
+			}
+			next++
+		}
+		for i := 0; i < round*2; i++ {
+			value, err := q.Dequeue()
+			if err != nil || value != expected {
+				t.Fatalf("Dequeue() = %d, %v, expected %d", value, err, expected)
+			}
+			expected++
+		}
+	}
+	for q.Len() > 0 {
+		value, _ := q.Dequeue()
+		if value != expected {
+			t.Fatalf("Dequeue() = %d, expected %d", value, expected)
+		}
+		expected++
+	}
+	if _, err := q.Dequeue(); !errors.Is(err, ErrEmptyQueue) {
+		t.Errorf("Dequeue() on empty queue error = %v, expected %v", err, ErrEmptyQueue)
+	}
+}
+
+func TestBoundedQueueOfferPoll(t *testing.T) {
+	q := NewBoundedQueue[string](2)
+	if !q.Offer("a") || !q.Offer("b") {
+		t.Fatal("Offer() rejected element in non-full queue")
+	}
+	if q.Offer("c") {
+		t.Error("Offer() accepted element in full queue")
+	}
+	if err := q.Enqueue("c"); !errors.Is(err, ErrFullQueue) {
+		t.Errorf("Enqueue() error = %v, expected %v", err, ErrFullQueue)
+	}
+
+	if value, ok := q.Poll(); !ok || value != "a" {
+		t.Errorf("Poll() = %s, %v, expected a, true", value, ok)
+	}
+	if !q.Offer("c") {
+		t.Error("Offer() rejected element after Poll")
+	}
+}
+
+func TestBlockingQueueContextCancel(t *testing.T) {
+	q := NewBoundedQueue[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := q.Put(ctx, 1); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := q.Put(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Put() into full queue error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+
+	q.Poll()
+	if _, err := q.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Take() from empty queue error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestBlockingQueueProducerConsumer(t *testing.T) {
+	const producers, perProducer = 4, 1000
+	q := NewBoundedQueue[int](8)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := q.Put(ctx, i); err != nil {
+					t.Errorf("Put() error = %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	sum := 0
+	for i := 0; i < producers*perProducer; i++ {
+		value, err := q.Take(ctx)
+		if err != nil {
+			t.Fatalf("Take() error = %v", err)
+		}
+		sum += value
+	}
+	wg.Wait()
+
+	if expected := producers * perProducer * (perProducer - 1) / 2; sum != expected {
+		t.Errorf("sum of taken values = %d, expected %d", sum, expected)
+	}
+}
@@ -0,0 +1,157 @@
+package set
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// ConcurrentSet - потокобезопасная обертка над Set. Операции над двумя множествами сначала снимают копию
+// other и только потом блокируют s, поэтому одновременные a.Union(b) и b.Union(a) не блокируют друг друга
+type ConcurrentSet[T comparable] struct {
+	mu  sync.RWMutex
+	set *Set[T]
+}
+
+func NewConcurrentSet[T comparable]() *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: NewSet[T]()}
+}
+
+func ConcurrentFromSlice[T comparable](items []T) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: FromSlice(items)}
+}
+
+func (s *ConcurrentSet[T]) Add(item T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(item)
+}
+
+// AddIfAbsent добавляет элемент и возвращает true, если его еще не было в множестве
+func (s *ConcurrentSet[T]) AddIfAbsent(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.set.Has(item) {
+		return false
+	}
+	s.set.Add(item)
+	return true
+}
+
+func (s *ConcurrentSet[T]) Remove(item T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Remove(item)
+}
+
+// RemoveIfPresent удаляет элемент и возвращает true, если он был в множестве
+func (s *ConcurrentSet[T]) RemoveIfPresent(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.set.Has(item) {
+		return false
+	}
+	s.set.Remove(item)
+	return true
+}
+
+func (s *ConcurrentSet[T]) Has(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Has(item)
+}
+
+func (s *ConcurrentSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Len()
+}
+
+// All перебирает копию элементов, снятую в момент вызова, поэтому не держит блокировку во время обхода
+func (s *ConcurrentSet[T]) All() iter.Seq[T] {
+	s.mu.RLock()
+	items := slices.Collect(s.set.All())
+	s.mu.RUnlock()
+	return slices.Values(items)
+}
+
+// Snapshot возвращает обычный Set с текущими элементами
+func (s *ConcurrentSet[T]) Snapshot() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+func (s *ConcurrentSet[T]) Clone() *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: s.Snapshot()}
+}
+
+func (s *ConcurrentSet[T]) String() string {
+	return s.Snapshot().String()
+}
+
+func (s *ConcurrentSet[T]) Union(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	return s.apply(other, (*Set[T]).Union)
+}
+
+func (s *ConcurrentSet[T]) Intersection(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	return s.apply(other, (*Set[T]).Intersection)
+}
+
+func (s *ConcurrentSet[T]) Difference(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	return s.apply(other, (*Set[T]).Difference)
+}
+
+func (s *ConcurrentSet[T]) SymmetricDifference(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	return s.apply(other, (*Set[T]).SymmetricDifference)
+}
+
+func (s *ConcurrentSet[T]) UnionInPlace(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	return s.applyInPlace(other, (*Set[T]).UnionInPlace)
+}
+
+func (s *ConcurrentSet[T]) IntersectionInPlace(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	return s.applyInPlace(other, (*Set[T]).IntersectionInPlace)
+}
+
+func (s *ConcurrentSet[T]) DifferenceInPlace(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	return s.applyInPlace(other, (*Set[T]).DifferenceInPlace)
+}
+
+func (s *ConcurrentSet[T]) SymmetricDifferenceInPlace(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	return s.applyInPlace(other, (*Set[T]).SymmetricDifferenceInPlace)
+}
+
+func (s *ConcurrentSet[T]) IsSubset(other *ConcurrentSet[T]) bool {
+	return s.compare(other, (*Set[T]).IsSubset)
+}
+
+func (s *ConcurrentSet[T]) IsSuperset(other *ConcurrentSet[T]) bool {
+	return s.compare(other, (*Set[T]).IsSuperset)
+}
+
+func (s *ConcurrentSet[T]) Equal(other *ConcurrentSet[T]) bool {
+	return s.compare(other, (*Set[T]).Equal)
+}
+
+func (s *ConcurrentSet[T]) apply(other *ConcurrentSet[T], op func(s, other *Set[T]) *Set[T]) *ConcurrentSet[T] {
+	snapshot := other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &ConcurrentSet[T]{set: op(s.set, snapshot)}
+}
+
+func (s *ConcurrentSet[T]) applyInPlace(other *ConcurrentSet[T], op func(s, other *Set[T]) *Set[T]) *ConcurrentSet[T] {
+	snapshot := other.Snapshot()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op(s.set, snapshot)
+	return s
+}
+
+func (s *ConcurrentSet[T]) compare(other *ConcurrentSet[T], op func(s, other *Set[T]) bool) bool {
+	snapshot := other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return op(s.set, snapshot)
+}
@@ -0,0 +1,85 @@
+package set
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestConcurrentSetAddIfAbsent(t *testing.T) {
+	s := NewConcurrentSet[int]()
+	var added atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := 0; item < 100; item++ {
+				if s.AddIfAbsent(item) {
+					added.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if added.Load() != 100 || s.Len() != 100 {
+		t.Errorf("AddIfAbsent() succeeded %d times, Len() = %d, expected 100", added.Load(), s.Len())
+	}
+}
+
+func TestConcurrentSetRemoveIfPresent(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+	s := ConcurrentFromSlice(items)
+	var removed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, item := range items {
+				if s.RemoveIfPresent(item) {
+					removed.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if removed.Load() != 100 || s.Len() != 0 {
+		t.Errorf("RemoveIfPresent() succeeded %d times, Len() = %d, expected 100, 0", removed.Load(), s.Len())
+	}
+}
+
+func TestConcurrentSetAlgebraRace(t *testing.T) {
+	a := ConcurrentFromSlice([]int{1, 2, 3})
+	b := ConcurrentFromSlice([]int{3, 4, 5})
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			a.UnionInPlace(b)
+		}()
+		go func() {
+			defer wg.Done()
+			b.IntersectionInPlace(a)
+		}()
+		go func() {
+			defer wg.Done()
+			a.Add(i)
+			b.Remove(i)
+		}()
+		go func() {
+			defer wg.Done()
+			for range a.All() {
+			}
+			_ = a.IsSuperset(b)
+			_ = a.SymmetricDifference(b).String()
+		}()
+	}
+	wg.Wait()
+}
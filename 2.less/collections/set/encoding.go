@@ -0,0 +1,151 @@
+package set
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Во всех форматах множество кодируется как список элементов, отсортированный через compareItems,
+// поэтому одно и то же множество всегда дает одинаковый результат
+
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.sortedItems())
+}
+
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	s.reset(items)
+	return nil
+}
+
+// MarshalText использует тот же JSON-массив, чтобы текстовое представление однозначно разбиралось обратно
+func (s *Set[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+func (s *Set[T]) UnmarshalText(data []byte) error {
+	return s.UnmarshalJSON(data)
+}
+
+// MarshalYAML и UnmarshalYAML поддерживаются gopkg.in/yaml.v2 и yaml.v3 без импорта самих библиотек
+func (s *Set[T]) MarshalYAML() (any, error) {
+	return s.sortedItems(), nil
+}
+
+func (s *Set[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var items []T
+	if err := unmarshal(&items); err != nil {
+		return err
+	}
+	s.reset(items)
+	return nil
+}
+
+func (s *Set[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.sortedItems()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *Set[T]) GobDecode(data []byte) error {
+	var items []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	s.reset(items)
+	return nil
+}
+
+func (s *Set[T]) reset(items []T) {
+	s.items = make(map[T]struct{}, len(items))
+	for _, item := range items {
+		s.Add(item)
+	}
+}
+
+func (s *Set[T]) sortedItems() []T {
+	items := slices.Collect(s.All())
+	slices.SortFunc(items, compareItems[T])
+	return items
+}
+
+// compareItems сравнивает числа и строки по значению, остальные типы - по строковому представлению
+func compareItems[T comparable](a, b T) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.IsValid() && vb.IsValid() && va.Kind() == vb.Kind() {
+		switch va.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(va.Int(), vb.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(va.Uint(), vb.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(va.Float(), vb.Float())
+		case reflect.String:
+			return cmp.Compare(va.String(), vb.String())
+		}
+	}
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func (s *ConcurrentSet[T]) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+func (s *ConcurrentSet[T]) UnmarshalJSON(data []byte) error {
+	set := NewSet[T]()
+	if err := set.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = set
+	return nil
+}
+
+func (s *ConcurrentSet[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+func (s *ConcurrentSet[T]) UnmarshalText(data []byte) error {
+	return s.UnmarshalJSON(data)
+}
+
+func (s *ConcurrentSet[T]) MarshalYAML() (any, error) {
+	return s.Snapshot().MarshalYAML()
+}
+
+func (s *ConcurrentSet[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	set := NewSet[T]()
+	if err := set.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = set
+	return nil
+}
+
+func (s *ConcurrentSet[T]) GobEncode() ([]byte, error) {
+	return s.Snapshot().GobEncode()
+}
+
+func (s *ConcurrentSet[T]) GobDecode(data []byte) error {
+	set := NewSet[T]()
+	if err := set.GobDecode(data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = set
+	return nil
+}
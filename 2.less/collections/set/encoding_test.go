@@ -0,0 +1,88 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+type participants struct {
+	ChatID string       `json:"chat_id"`
+	Users  *Set[string] `json:"users"`
+	Admins *Set[int]    `json:"admins"`
+}
+
+func TestSetJSON(t *testing.T) {
+	payload := participants{
+		ChatID: "chat",
+		Users:  FromSlice([]string{"carol", "alice", "bob"}),
+		Admins: FromSlice([]int{10, 2, 1}),
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	expected := `{"chat_id":"chat","users":["alice","bob","carol"],"admins":[1,2,10]}`
+	if string(data) != expected {
+		t.Errorf("Marshal() = %s, expected %s", data, expected)
+	}
+
+	var decoded participants
+	if err := json.Unmarshal([]byte(`{"users":["bob","alice","bob"],"admins":[]}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !decoded.Users.Equal(FromSlice([]string{"alice", "bob"})) || decoded.Admins.Len() != 0 {
+		t.Errorf("Unmarshal() = %s, %s", decoded.Users, decoded.Admins)
+	}
+}
+
+func TestSetText(t *testing.T) {
+	s := FromSlice([]string{"b", "a"})
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+
+	decoded := &Set[string]{}
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText(%s) error = %v", text, err)
+	}
+	if !decoded.Equal(s) {
+		t.Errorf("UnmarshalText() = %s, expected %s", decoded, s)
+	}
+}
+
+func TestSetGob(t *testing.T) {
+	var buf bytes.Buffer
+	src := ConcurrentFromSlice([]int{3, 1, 2})
+	if err := gob.NewEncoder(&buf).Encode(src); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	dst := NewConcurrentSet[int]()
+	if err := gob.NewDecoder(&buf).Decode(dst); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !dst.Equal(src) {
+		t.Errorf("Decode() = %s, expected %s", dst, src)
+	}
+}
+
+func TestSetYAML(t *testing.T) {
+	s := FromSlice([]int{2, 1})
+	out, err := s.MarshalYAML()
+	if err != nil {
+		t.Fatalf("MarshalYAML() error = %v", err)
+	}
+
+	decoded := &Set[int]{}
+	err = decoded.UnmarshalYAML(func(v any) error {
+		*v.(*[]int) = out.([]int)
+		return nil
+	})
+	if err != nil || !decoded.Equal(s) {
+		t.Errorf("UnmarshalYAML() = %s, %v, expected %s", decoded, err, s)
+	}
+}
@@ -0,0 +1,39 @@
+package set
+
+import "testing"
+
+// FuzzSetAlgebraLaws проверяет законы алгебры множеств на множествах из произвольных байтов
+func FuzzSetAlgebraLaws(f *testing.F) {
+	f.Add([]byte{1, 2, 3}, []byte{2, 3, 4})
+	f.Add([]byte{}, []byte{5})
+	f.Add([]byte{7, 7, 7}, []byte{7})
+
+	f.Fuzz(func(t *testing.T, as, bs []byte) {
+		a, b := FromSlice(as), FromSlice(bs)
+		union, intersection := a.Union(b), a.Intersection(b)
+
+		laws := []struct {
+			name string
+			ok   bool
+		}{
+			{"union is commutative", union.Equal(b.Union(a))},
+			{"intersection is commutative", intersection.Equal(b.Intersection(a))},
+			{"a is subset of union", a.IsSubset(union) && union.IsSuperset(a)},
+			{"intersection is subset of a", intersection.IsSubset(a)},
+			{"a = (a \\ b) ∪ (a ∩ b)", a.Difference(b).Union(intersection).Equal(a)},
+			{"a \\ b and b are disjoint", a.Difference(b).Intersection(b).Len() == 0},
+			{"a Δ b = (a ∪ b) \\ (a ∩ b)", a.SymmetricDifference(b).Equal(union.Difference(intersection))},
+			{"|a ∪ b| = |a| + |b| - |a ∩ b|", union.Len() == a.Len()+b.Len()-intersection.Len()},
+			{"in-place union matches union", a.Clone().UnionInPlace(b).Equal(union)},
+			{"in-place intersection matches intersection", a.Clone().IntersectionInPlace(b).Equal(intersection)},
+			{"in-place difference matches difference", a.Clone().DifferenceInPlace(b).Equal(a.Difference(b))},
+			{"in-place symmetric difference matches", a.Clone().SymmetricDifferenceInPlace(b).Equal(a.SymmetricDifference(b))},
+			{"operands are not changed", a.Equal(FromSlice(as)) && b.Equal(FromSlice(bs))},
+		}
+		for _, law := range laws {
+			if !law.ok {
+				t.Errorf("%s: a = %s, b = %s", law.name, a, b)
+			}
+		}
+	})
+}
@@ -0,0 +1,158 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+)
+
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+func NewSet[T comparable]() *Set[T] {
+	return &Set[T]{
+		items: make(map[T]struct{}),
+	}
+}
+
+func FromSlice[T comparable](items []T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+func (s *Set[T]) Add(item T) {
+	s.items[item] = struct{}{}
+}
+
+func (s *Set[T]) Remove(item T) {
+	delete(s.items, item)
+}
+
+func (s *Set[T]) Has(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// All возвращает элементы множества в произвольном порядке
+func (s *Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s.items)
+}
+
+func (s *Set[T]) Clone() *Set[T] {
+	return &Set[T]{items: maps.Clone(s.items)}
+}
+
+func (s *Set[T]) String() string {
+	return fmt.Sprintf("%v", s.sortedItems())
+}
+
+// ToSortedSlice возвращает элементы множества, отсортированные по возрастанию
+func ToSortedSlice[T cmp.Ordered](s *Set[T]) []T {
+	return slices.Sorted(s.All())
+}
+
+// Операции над множествами не меняют ни s, ни other и всегда возвращают новое множество.
+// Для изменения s на месте есть варианты с суффиксом InPlace
+
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	return s.Clone().UnionInPlace(other)
+}
+
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	setIntersect := NewSet[T]()
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	for item := range small.items {
+		if large.Has(item) {
+			setIntersect.Add(item)
+		}
+	}
+
+	return setIntersect
+}
+
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	setDifference := NewSet[T]()
+	for item := range s.items {
+		if !other.Has(item) {
+			setDifference.Add(item)
+		}
+	}
+
+	return setDifference
+}
+
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return s.Difference(other).UnionInPlace(other.Difference(s))
+}
+
+func (s *Set[T]) UnionInPlace(other *Set[T]) *Set[T] {
+	for item := range other.items {
+		s.Add(item)
+	}
+
+	return s
+}
+
+func (s *Set[T]) IntersectionInPlace(other *Set[T]) *Set[T] {
+	for item := range s.items {
+		if !other.Has(item) {
+			s.Remove(item)
+		}
+	}
+
+	return s
+}
+
+func (s *Set[T]) DifferenceInPlace(other *Set[T]) *Set[T] {
+	for item := range other.items {
+		s.Remove(item)
+	}
+
+	return s
+}
+
+func (s *Set[T]) SymmetricDifferenceInPlace(other *Set[T]) *Set[T] {
+	for item := range other.items {
+		if s.Has(item) {
+			s.Remove(item)
+		} else {
+			s.Add(item)
+		}
+	}
+
+	return s
+}
+
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for item := range s.items {
+		if !other.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
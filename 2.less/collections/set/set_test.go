@@ -0,0 +1,75 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetAlgebraReturnsNewSets(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(a, b *Set[int]) *Set[int]
+		expected []int
+	}{
+		{name: "Union", op: (*Set[int]).Union, expected: []int{1, 2, 3, 4}},
+		{name: "Intersection", op: (*Set[int]).Intersection, expected: []int{2, 3}},
+		{name: "Difference", op: (*Set[int]).Difference, expected: []int{1}},
+		{name: "SymmetricDifference", op: (*Set[int]).SymmetricDifference, expected: []int{1, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := FromSlice([]int{1, 2, 3}), FromSlice([]int{2, 3, 4})
+			result := tt.op(a, b)
+
+			if got := ToSortedSlice(result); !slices.Equal(got, tt.expected) {
+				t.Errorf("%s() = %v, expected %v", tt.name, got, tt.expected)
+			}
+			if got := ToSortedSlice(a); !slices.Equal(got, []int{1, 2, 3}) {
+				t.Errorf("%s() changed receiver to %v", tt.name, got)
+			}
+			if got := ToSortedSlice(b); !slices.Equal(got, []int{2, 3, 4}) {
+				t.Errorf("%s() changed argument to %v", tt.name, got)
+			}
+		})
+	}
+}
+
+func TestSetAlgebraInPlace(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(a, b *Set[int]) *Set[int]
+		expected []int
+	}{
+		{name: "UnionInPlace", op: (*Set[int]).UnionInPlace, expected: []int{1, 2, 3, 4}},
+		{name: "IntersectionInPlace", op: (*Set[int]).IntersectionInPlace, expected: []int{2, 3}},
+		{name: "DifferenceInPlace", op: (*Set[int]).DifferenceInPlace, expected: []int{1}},
+		{name: "SymmetricDifferenceInPlace", op: (*Set[int]).SymmetricDifferenceInPlace, expected: []int{1, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := FromSlice([]int{1, 2, 3})
+			if result := tt.op(a, FromSlice([]int{2, 3, 4})); result != a {
+				t.Fatalf("%s() returned a new set", tt.name)
+			}
+			if got := ToSortedSlice(a); !slices.Equal(got, tt.expected) {
+				t.Errorf("%s() = %v, expected %v", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetRelations(t *testing.T) {
+	a, b := FromSlice([]int{1, 2}), FromSlice([]int{1, 2, 3})
+
+	if !a.IsSubset(b) || b.IsSubset(a) {
+		t.Error("IsSubset() returned wrong result")
+	}
+	if !b.IsSuperset(a) || a.IsSuperset(b) {
+		t.Error("IsSuperset() returned wrong result")
+	}
+	if a.Equal(b) || !a.Equal(FromSlice([]int{2, 1})) {
+		t.Error("Equal() returned wrong result")
+	}
+}
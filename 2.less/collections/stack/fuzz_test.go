@@ -0,0 +1,55 @@
+package stack
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// FuzzStackLIFO сверяет ограниченный Stack с моделью на срезе. Старший бит байта - Pop, остальные - значение
+func FuzzStackLIFO(f *testing.F) {
+	f.Add([]byte{1, 2, 3, 0x80, 4, 0x80, 0x80, 0x80, 0x80})
+	f.Add([]byte{1, 2, 3, 4, 5, 6})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		const maxDepth = 4
+		s := NewBoundedStack[byte](maxDepth)
+		var model []byte
+
+		for i, op := range ops {
+			if op&0x80 == 0 {
+				err := s.Push(op)
+				if len(model) == maxDepth {
+					if !errors.Is(err, ErrStackOverflow) {
+						t.Fatalf("op %d: Push() into full stack error = %v", i, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("op %d: Push() error = %v", i, err)
+				}
+				model = append(model, op)
+				continue
+			}
+
+			value, err := s.Pop()
+			if len(model) == 0 {
+				if !errors.Is(err, ErrEmptyStack) {
+					t.Fatalf("op %d: Pop() from empty stack error = %v", i, err)
+				}
+				continue
+			}
+			expected := model[len(model)-1]
+			model = model[:len(model)-1]
+			if err != nil || value != expected {
+				t.Fatalf("op %d: Pop() = %d, %v, expected %d", i, value, err, expected)
+			}
+		}
+
+		reversed := slices.Clone(model)
+		slices.Reverse(reversed)
+		if got := slices.Collect(s.Snapshot()); !slices.Equal(got, reversed) {
+			t.Errorf("Snapshot() = %v, expected %v", got, reversed)
+		}
+	})
+}
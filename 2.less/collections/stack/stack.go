@@ -0,0 +1,115 @@
+package stack
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"sync"
+)
+
+var (
+	ErrEmptyStack    = errors.New("empty stack")
+	ErrStackOverflow = errors.New("stack overflow")
+)
+
+type Stack[T any] struct {
+	stack    []T
+	maxDepth int // 0 - без ограничения
+}
+
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// NewBoundedStack создает стек глубиной не больше maxDepth: Push в заполненный стек возвращает ErrStackOverflow
+func NewBoundedStack[T any](maxDepth int) *Stack[T] {
+	return &Stack[T]{maxDepth: max(maxDepth, 1)}
+}
+
+func (s *Stack[T]) Push(x T) error {
+	if s.maxDepth > 0 && len(s.stack) >= s.maxDepth {
+		return ErrStackOverflow
+	}
+	s.stack = append(s.stack, x)
+	return nil
+}
+
+func (s *Stack[T]) Pop() (T, error) {
+	var zero T
+	if len(s.stack) == 0 {
+		return zero, ErrEmptyStack
+	}
+
+	x := s.stack[len(s.stack)-1]
+	s.stack[len(s.stack)-1] = zero
+	s.stack = s.stack[:len(s.stack)-1]
+	return x, nil
+}
+
+func (s *Stack[T]) Peek() (T, error) {
+	if len(s.stack) == 0 {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+	return s.stack[len(s.stack)-1], nil
+}
+
+func (s *Stack[T]) Len() int {
+	return len(s.stack)
+}
+
+// Snapshot перебирает копию элементов от вершины ко дну, не извлекая их из стека
+func (s *Stack[T]) Snapshot() iter.Seq[T] {
+	items := slices.Clone(s.stack)
+	return func(yield func(T) bool) {
+		for _, x := range slices.Backward(items) {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// ConcurrentStack - потокобезопасная обертка над Stack
+type ConcurrentStack[T any] struct {
+	mu    sync.Mutex
+	stack *Stack[T]
+}
+
+func NewConcurrentStack[T any]() *ConcurrentStack[T] {
+	return &ConcurrentStack[T]{stack: NewStack[T]()}
+}
+
+func NewBoundedConcurrentStack[T any](maxDepth int) *ConcurrentStack[T] {
+	return &ConcurrentStack[T]{stack: NewBoundedStack[T](maxDepth)}
+}
+
+func (s *ConcurrentStack[T]) Push(x T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Push(x)
+}
+
+func (s *ConcurrentStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+func (s *ConcurrentStack[T]) Peek() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Peek()
+}
+
+func (s *ConcurrentStack[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Len()
+}
+
+func (s *ConcurrentStack[T]) Snapshot() iter.Seq[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Snapshot()
+}
@@ -0,0 +1,75 @@
+package stack
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	s := NewStack[string]()
+	if _, err := s.Pop(); !errors.Is(err, ErrEmptyStack) {
+		t.Errorf("Pop() on empty stack error = %v, expected %v", err, ErrEmptyStack)
+	}
+	for _, x := range []string{"a", "b", "c"} {
+		if err := s.Push(x); err != nil {
+			t.Fatalf("Push() error = %v", err)
+		}
+	}
+
+	snapshot := s.Snapshot()
+	if top, err := s.Peek(); err != nil || top != "c" {
+		t.Errorf("Peek() = %s, %v, expected c", top, err)
+	}
+	if top, _ := s.Pop(); top != "c" {
+		t.Errorf("Pop() = %s, expected c", top)
+	}
+	// снимок сделан до Pop и не меняется вместе со стеком
+	if got := slices.Collect(snapshot); !slices.Equal(got, []string{"c", "b", "a"}) {
+		t.Errorf("Snapshot() = %v, expected [c b a]", got)
+	}
+	if s.Len() != 2 {
+		t.Errorf("Len() = %d, expected 2", s.Len())
+	}
+}
+
+func TestBoundedStack(t *testing.T) {
+	s := NewBoundedStack[int](2)
+	_ = s.Push(1)
+	_ = s.Push(2)
+	if err := s.Push(3); !errors.Is(err, ErrStackOverflow) {
+		t.Errorf("Push() into full stack error = %v, expected %v", err, ErrStackOverflow)
+	}
+	_, _ = s.Pop()
+	if err := s.Push(3); err != nil {
+		t.Errorf("Push() after Pop error = %v", err)
+	}
+}
+
+func TestConcurrentStack(t *testing.T) {
+	s := NewBoundedConcurrentStack[int](100)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	rejected := 0
+	for g := 0; g < 10; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				if errors.Is(s.Push(i), ErrStackOverflow) {
+					mu.Lock()
+					rejected++
+					mu.Unlock()
+				}
+				for range s.Snapshot() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Len() != 100 || rejected != 100 {
+		t.Errorf("Len() = %d, rejected = %d, expected 100, 100", s.Len(), rejected)
+	}
+}
@@ -1,40 +1,37 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
 
-type Node struct {
-	val  int
-	next *Node
-}
-
-func (n *Node) Push(node *Node, val int) *Node {
-	if node == nil {
-		return &Node{val: val}
-	}
-
-	current := node
-	for current.next != nil {
-		current = node.next
-	}
-	current.next = &Node{val: val}
+	"2less/collections/list"
+)
 
-	return node
-}
-
-func Print(node *Node) {
-	current := node
-	for current != nil {
-		fmt.Printf("%d -> ", current.val)
-		current = current.next
+func Print[T any](l *list.List[T]) {
+	for val := range l.All() {
+		fmt.Printf("%v -> ", val)
 	}
 	fmt.Print(nil)
+	fmt.Println()
 }
 
 func main() {
-	var head *Node
+	l := list.NewList[int]()
+
+	l.PushBack(1)
+	five := l.PushBack(5)
+	l.PushBack(7)
+	l.PushFront(0)
+	l.InsertBefore(3, five)
+	l.InsertAfter(6, five)
 
-	head = head.Push(head, 1)
-	head = head.Push(head, 5)
+	Print(l)
 
-	Print(head)
+	l.MoveToFront(five)
+	l.Remove(l.Back())
+	Print(l)
+
+	for val := range l.Backward() {
+		fmt.Printf("%d <- ", val)
+	}
+	fmt.Printf("len: %d\n", l.Len())
 }
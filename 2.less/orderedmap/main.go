@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"2less/collections/orderedmap"
+)
+
+func main() {
+	// история сообщений чата, упорядоченная по времени отправки
+	history := orderedmap.NewFunc[time.Time, string](time.Time.Compare)
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	history.Set(start.Add(3*time.Minute), "как дела?")
+	history.Set(start, "привет")
+	history.Set(start.Add(5*time.Minute), "отлично")
+	history.Set(start.Add(time.Minute), "привет!")
+
+	for sentAt, text := range history.All() {
+		fmt.Printf("%s %s\n", sentAt.Format(time.TimeOnly), text)
+	}
+	fmt.Println("---")
+
+	// сообщения за первые 4 минуты
+	for sentAt, text := range history.Range(start, start.Add(4*time.Minute)) {
+		fmt.Printf("%s %s\n", sentAt.Format(time.TimeOnly), text)
+	}
+	fmt.Println("---")
+
+	// последнее сообщение не позже 12:04
+	if sentAt, text, ok := history.Floor(start.Add(4 * time.Minute)); ok {
+		fmt.Printf("Floor: %s %s\n", sentAt.Format(time.TimeOnly), text)
+	}
+	if sentAt, text, ok := history.Ceiling(start.Add(4 * time.Minute)); ok {
+		fmt.Printf("Ceiling: %s %s\n", sentAt.Format(time.TimeOnly), text)
+	}
+}
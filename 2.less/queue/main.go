@@ -1,38 +1,12 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"time"
 
-type Queue []int
-
-func (q *Queue) Enqueue(value int) {
-	*q = append(*q, value)
-}
-
-func (q *Queue) Dequeue() (int, error) {
-	if len(*q) == 0 {
-		return 0, fmt.Errorf("empty queue")
-	}
-	element := (*q)[0]
-	*q = (*q)[1:]
-	return element, nil
-}
-
-type Queue2 struct {
-	queue []int
-}
-
-func (q *Queue2) Add(value int) {
-	q.queue = append(q.queue, value)
-}
-
-func (q *Queue2) Remove() (int, error) {
-	if len(q.queue) == 0 || q.queue == nil {
-		return 0, fmt.Errorf("empty queue")
-	}
-	element := q.queue[0]
-	q.queue = q.queue[1:]
-	return element, nil
-}
+	"2less/collections/queue"
+)
 
 func main() {
 	// Создание очереди без отдельного типа на слайсах
@@ -52,37 +26,17 @@ func main() {
 	//	fmt.Println(value)
 	//}
 
-	// Создание очереди с типом на слайсах
-	//queue := Queue{}
-	//
-	//// Добавление элементов в очередь
-	//queue.Enqueue(1)
-	//queue.Enqueue(2)
-	//queue.Enqueue(3)
-	//
-	//// Удалить и распечатать каждый элемент
-	//for len(queue) > 0 {
-	//	element, err := queue.Dequeue()
-	//	if err != nil {
-	//		fmt.Println(err)
-	//		break
-	//	}
-	//	fmt.Println(element)
-	//}
+	// Очередь на кольцевом буфере
+	q := queue.NewQueue[int]()
 
-	//Создание очереди на структуре со слайсами
-	queue2 := Queue2{}
-	queue2.Add(1)
-	queue2.Add(2)
-	queue2.Add(3)
-	if len(queue2.queue) > 0 {
-		for _, value := range queue2.queue {
-			fmt.Println(value)
-		}
+	// Добавление элементов в очередь
+	for i := 1; i <= 3; i++ {
+		_ = q.Enqueue(i)
 	}
-	fmt.Println("---")
-	for len(queue2.queue) > 0 {
-		element, err := queue2.Remove()
+
+	// Удалить и распечатать каждый элемент
+	for q.Len() > 0 {
+		element, err := q.Dequeue()
 		if err != nil {
 			fmt.Println(err)
 			break
@@ -90,4 +44,24 @@ func main() {
 		fmt.Println(element)
 	}
 
+	// Ограниченная очередь: производитель ждет, пока потребитель освободит место
+	bounded := queue.NewBoundedQueue[string](1)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	go func() {
+		for _, msg := range []string{"a", "b", "c"} {
+			if err := bounded.Put(ctx, msg); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 3; i++ {
+		msg, err := bounded.Take(ctx)
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(msg)
+	}
 }
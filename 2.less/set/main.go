@@ -2,121 +2,35 @@ package main
 
 import (
 	"fmt"
-	"sort"
-)
-
-type Set struct {
-	items map[string]struct{}
-}
-
-func NewSet() *Set {
-	return &Set{
-		items: make(map[string]struct{}),
-	}
-}
-
-func (s *Set) Add(item string) {
-	s.items[item] = struct{}{}
-}
-
-func (s *Set) Remove(item string) {
-	delete(s.items, item)
-}
-
-func (s *Set) Has(item string) bool {
-	_, ok := s.items[item]
-	return ok
-}
-
-func (s *Set) Len() int {
-	return len(s.items)
-}
-
-func (s *Set) getItems() []string {
-	items := make([]string, 0, len(s.items))
-	for item := range s.items {
-		items = append(items, item)
-	}
-	sort.Strings(items)
-	return items
-}
-
-func (s *Set) String() string {
-	return fmt.Sprintf("%v", s.getItems())
-}
-
-func (s *Set) Union(other *Set) *Set {
-	for item := range other.items {
-		s.Add(item)
-	}
 
-	return s
-}
-
-func (s *Set) Intersection(other *Set) *Set {
-	setIntersect := NewSet()
-
-	for item := range other.items {
-		if s.Has(item) {
-			setIntersect.Add(item)
-		}
-	}
-
-	return setIntersect
-}
-
-func (s *Set) Difference(other *Set) *Set {
-	setDifference := NewSet()
-	for item := range s.items {
-		if !other.Has(item) {
-			setDifference.Add(item)
-		}
-	}
-
-	return setDifference
-}
-
-func (s *Set) IsSubset(other *Set) bool {
-	for item := range s.items {
-		if !other.Has(item) {
-			return false
-		}
-	}
-
-	return true
-}
-
-func (s *Set) IsSuperset(other *Set) bool {
-	return other.IsSubset(s)
-}
+	"2less/collections/set"
+)
 
 func main() {
-	set := NewSet()
-	set.Add("a")
-	set.Add("b")
-	set.Add("c")
-
-	fmt.Printf("Длина множества: %d\n", set.Len())
-	fmt.Printf("Проверка наличность элементов множества: %t\n", set.Has("a"))
-	set.Remove("a")
-	fmt.Printf("Проверка наличность элементов множества: %t\n", set.Has("a"))
-	fmt.Printf("Вывод множества: %s\n", set)
-
-	set2 := NewSet()
-	//set2.Add("a")
-	set2.Add("b")
-	//set2.Add("dd")
+	s := set.NewSet[string]()
+	s.Add("a")
+	s.Add("b")
+	s.Add("c")
+
+	fmt.Printf("Длина множества: %d\n", s.Len())
+	fmt.Printf("Проверка наличность элементов множества: %t\n", s.Has("a"))
+	s.Remove("a")
+	fmt.Printf("Проверка наличность элементов множества: %t\n", s.Has("a"))
+	fmt.Printf("Вывод множества: %s\n", s)
+
+	set2 := set.FromSlice([]string{"b", "d"})
 	fmt.Printf("Вывод множества2: %s\n", set2)
-	//set.Union(set2)
-	//fmt.Println(set)
 
-	//res := set.Intersection(set2)
-	//fmt.Println(res)
+	fmt.Printf("Объединение: %s\n", s.Union(set2))
+	fmt.Printf("Пересечение: %s\n", s.Intersection(set2))
+	fmt.Printf("Разность: %s\n", s.Difference(set2))
+	fmt.Printf("Симметрическая разность: %s\n", s.SymmetricDifference(set2))
+	fmt.Printf("Исходное множество не изменилось: %s\n", s)
 
-	//dif := set.Difference(set2)
-	//fmt.Println(dif)
-
-	//fmt.Print(set2.IsSubset(set))
-	fmt.Print(set.IsSuperset(set2))
+	for item := range s.All() {
+		fmt.Println(item)
+	}
+	fmt.Println(set.ToSortedSlice(s))
 
+	fmt.Println(s.IsSuperset(set.FromSlice([]string{"b"})))
 }
@@ -1,40 +1,10 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
 
-type Stack []int
-
-func (s *Stack) Push(x int) {
-	*s = append(*s, x)
-}
-
-func (s *Stack) Pop() (int, error) {
-	if len(*s) == 0 {
-		return 0, fmt.Errorf("empty queue")
-	}
-
-	x := (*s)[len(*s)-1]
-	*s = (*s)[0 : len(*s)-1]
-	return x, nil
-}
-
-type Stack2 struct {
-	stack []int
-}
-
-func (s *Stack2) Push(x int) {
-	s.stack = append(s.stack, x)
-}
-
-func (s *Stack2) Pop() (int, error) {
-	if len(s.stack) == 0 {
-		return 0, fmt.Errorf("empty queue")
-	}
-
-	x := s.stack[len(s.stack)-1]
-	s.stack = s.stack[:len(s.stack)-1]
-	return x, nil
-}
+	"2less/collections/stack"
+)
 
 func main() {
 	//stack := []int{1}
@@ -51,28 +21,18 @@ func main() {
 	//
 	//fmt.Println(stack)
 
-	//stack := Stack{}
-	//stack.Push(1)
-	//stack.Push(2)
-	//
-	//for _, value := range stack {
-	//	fmt.Println(value)
-	//}
-	//
-	//for len(stack) > 0 {
-	//	element, _ := stack.Pop()
-	//	fmt.Println(element)
-	//}
-
-	stack := Stack2{}
-	stack.Push(1)
-	stack.Push(2)
+	stack := stack.NewBoundedStack[int](2)
+	_ = stack.Push(1)
+	_ = stack.Push(2)
+	if err := stack.Push(3); err != nil {
+		fmt.Println(err)
+	}
 
-	for _, value := range stack.stack {
+	for value := range stack.Snapshot() {
 		fmt.Println(value)
 	}
 
-	for len(stack.stack) > 0 {
+	for stack.Len() > 0 {
 		element, _ := stack.Pop()
 		fmt.Println(element)
 	}
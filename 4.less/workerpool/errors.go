@@ -0,0 +1,57 @@
+package workerpool
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TaskError - ошибка задачи с ее индексом в срезе, переданном в Run или Start
+type TaskError struct {
+	Index int
+	Err   error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("task %d: %v", e.Index, e.Err)
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError - итог Run и Start, если не все задачи выполнены успешно.
+// Cause - причина остановки пула (ErrErrorsLimitExceeded или ошибка контекста), nil если пул
+// не останавливался досрочно. Skipped - задачи, которые не были запущены
+type MultiError struct {
+	Errors    []*TaskError
+	Cause     error
+	Succeeded int
+	Failed    int
+	Skipped   int
+}
+
+func (e *MultiError) Error() string {
+	var b strings.Builder
+	if e.Cause != nil {
+		b.WriteString(e.Cause.Error())
+		b.WriteString(": ")
+	}
+	fmt.Fprintf(&b, "%d succeeded, %d failed, %d skipped", e.Succeeded, e.Failed, e.Skipped)
+	for _, err := range e.Errors {
+		b.WriteString("; ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap позволяет проверять через errors.Is и errors.As как причину остановки, так и ошибки задач
+func (e *MultiError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors)+1)
+	if e.Cause != nil {
+		errs = append(errs, e.Cause)
+	}
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
@@ -0,0 +1,40 @@
+package workerpool
+
+import "context"
+
+// Future - результат задачи, поставленной в пул через Submit
+type Future struct {
+	done    chan struct{}
+	err     error
+	skipped bool
+}
+
+func newFuture() *Future {
+	return &Future{done: make(chan struct{})}
+}
+
+func (f *Future) complete(err error) {
+	f.err = err
+	close(f.done)
+}
+
+// skip завершает Future задачи, которая не была запущена из-за остановки пула
+func (f *Future) skip() {
+	f.skipped = true
+	f.complete(ErrPoolStopped)
+}
+
+// Done закрывается, когда задача выполнена или отменена
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait ждет завершения задачи и возвращает ее ошибку либо ошибку ctx, если ожидание прервано
+func (f *Future) Wait(ctx context.Context) error {
+	select {
+	case <-f.done:
+		return f.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,89 @@
+package workerpool
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy задает повторный запуск упавших задач. В лимит ошибок пула засчитывается
+// только ошибка последней попытки
+type RetryPolicy struct {
+	// MaxAttempts - общее число попыток, включая первую. При значении <= 1 повторов нет
+	MaxAttempts int
+	// InitialBackoff - пауза перед первым повтором, дальше она умножается на Multiplier
+	InitialBackoff time.Duration
+	// MaxBackoff ограничивает паузу сверху, 0 - без ограничения
+	MaxBackoff time.Duration
+	// Multiplier по умолчанию равен 2
+	Multiplier float64
+	// Jitter - доля паузы от 0 до 1, на которую она случайно уменьшается или увеличивается
+	Jitter float64
+	// Retryable решает, стоит ли повторять задачу после ошибки. nil - повторять любую ошибку
+	Retryable func(err error) bool
+}
+
+// WithRetryPolicy задает политику повторов для всех задач пула
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(wp *WorkerPool) {
+		wp.retry = policy
+	}
+}
+
+// WithRetry задает политику повторов для задачи, переопределяя WithRetryPolicy пула
+func WithRetry(policy RetryPolicy) TaskOption {
+	return func(j *job) {
+		j.retry = &policy
+	}
+}
+
+func (p RetryPolicy) shouldRetry(attempt int, err error) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+// backoff возвращает паузу перед попыткой attempt+1
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	// без MaxBackoff пауза ограничена максимальным time.Duration, иначе она переполнится
+	limit := float64(math.MaxInt64)
+	if p.MaxBackoff > 0 {
+		limit = float64(p.MaxBackoff)
+	}
+
+	d := float64(p.InitialBackoff)
+	for i := 1; i < attempt && d < limit; i++ {
+		d *= multiplier
+	}
+	d = min(d, limit)
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		d += d * jitter * (2*rand.Float64() - 1)
+	}
+	// float64(math.MaxInt64) округляется вверх, поэтому граница проверяется перед преобразованием
+	if d >= float64(math.MaxInt64) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(d)
+}
+
+// sleep ждет паузу перед повтором и возвращает false, если ctx завершился раньше
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
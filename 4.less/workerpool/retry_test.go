@@ -0,0 +1,138 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryOnlyFinalFailureCounts(t *testing.T) {
+	errTransient := errors.New("transient")
+	var calls atomic.Int32
+	tasks := []Task{
+		func() error {
+			if calls.Add(1) < 3 {
+				return errTransient
+			}
+			return nil
+		},
+	}
+
+	wp := NewWorkerPool(1, 1, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}))
+	if err := wp.Start(tasks); err != nil {
+		t.Errorf("Start() error = %v, expected nil", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("task called %d times, expected 3", calls.Load())
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	errTransient := errors.New("transient")
+	errFatal := errors.New("fatal")
+	retryable := func(err error) bool { return errors.Is(err, errTransient) }
+
+	tests := []struct {
+		name          string
+		policy        RetryPolicy
+		err           error
+		expectedCalls int32
+	}{
+		{
+			name:          "No retry by default",
+			policy:        RetryPolicy{},
+			err:           errTransient,
+			expectedCalls: 1,
+		},
+		{
+			name:          "Attempts exhausted",
+			policy:        RetryPolicy{MaxAttempts: 4, Retryable: retryable},
+			err:           errTransient,
+			expectedCalls: 4,
+		},
+		{
+			name:          "Error is not retryable",
+			policy:        RetryPolicy{MaxAttempts: 4, Retryable: retryable},
+			err:           errFatal,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				wp := NewWorkerPool(1, 0)
+				defer wp.Stop()
+
+				var calls atomic.Int32
+				future, err := wp.Submit(context.Background(), func() error {
+					calls.Add(1)
+					return tt.err
+				}, WithRetry(tt.policy))
+				if err != nil {
+					t.Fatalf("Submit() error = %v", err)
+				}
+				if err := future.Wait(context.Background()); !errors.Is(err, tt.err) {
+					t.Errorf("Wait() error = %v, expected %v", err, tt.err)
+				}
+				if calls.Load() != tt.expectedCalls {
+					t.Errorf("task called %d times, expected %d", calls.Load(), tt.expectedCalls)
+				}
+			},
+		)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	expected := []time.Duration{10, 20, 40, 50, 50}
+	for i, want := range expected {
+		if got := policy.backoff(i + 1); got != want*time.Millisecond {
+			t.Errorf("backoff(%d) = %v, expected %v", i+1, got, want*time.Millisecond)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for attempt := 1; attempt <= 5; attempt++ {
+		got := policy.backoff(attempt)
+		if got < 5*time.Millisecond || got > 75*time.Millisecond {
+			t.Errorf("backoff(%d) with jitter = %v, out of range", attempt, got)
+		}
+	}
+
+	// без MaxBackoff пауза растет до максимального time.Duration, но не переполняется
+	unbounded := RetryPolicy{InitialBackoff: time.Second, MaxAttempts: 100}
+	prev := time.Duration(0)
+	for attempt := 1; attempt <= 100; attempt++ {
+		got := unbounded.backoff(attempt)
+		if got < prev {
+			t.Fatalf("backoff(%d) = %v, less than backoff(%d) = %v", attempt, got, attempt-1, prev)
+		}
+		prev = got
+	}
+	if got := unbounded.backoff(40); got != time.Duration(math.MaxInt64) {
+		t.Errorf("backoff(40) = %v, expected %v", got, time.Duration(math.MaxInt64))
+	}
+	unbounded.Jitter = 0.5
+	if got := unbounded.backoff(100); got <= 0 {
+		t.Errorf("backoff(100) with jitter = %v, expected positive", got)
+	}
+}
+
+func TestRetryStopsOnPoolStop(t *testing.T) {
+	wp := NewWorkerPool(1, 0, WithRetryPolicy(RetryPolicy{MaxAttempts: 100, InitialBackoff: time.Hour}))
+	errTask := errors.New("task error")
+	future, err := wp.Submit(context.Background(), func() error { return errTask })
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	wp.Stop()
+	if err := future.Wait(context.Background()); !errors.Is(err, errTask) {
+		t.Errorf("Wait() error = %v, expected %v", err, errTask)
+	}
+}
@@ -0,0 +1,100 @@
+package workerpool
+
+import "time"
+
+const defaultIdleTimeout = 10 * time.Second
+
+// WithMinWorkers задает минимальное число воркеров, не меньше одного. Если оно меньше максимального, пул
+// запускает дополнительные воркеры при росте очереди и останавливает простаивающие
+func WithMinWorkers(n int) Option {
+	return func(wp *WorkerPool) {
+		wp.minWorkers = n
+	}
+}
+
+// WithMaxWorkers задает максимальное число воркеров, по умолчанию равно n из NewWorkerPool
+func WithMaxWorkers(n int) Option {
+	return func(wp *WorkerPool) {
+		wp.maxWorkers = n
+	}
+}
+
+// WithIdleTimeout задает время простоя, после которого воркер сверх минимума завершается
+func WithIdleTimeout(timeout time.Duration) Option {
+	return func(wp *WorkerPool) {
+		wp.idleTimeout = timeout
+	}
+}
+
+// Resize задает фиксированное число воркеров n, отключая автомасштабирование. Лишние воркеры
+// завершаются после текущих задач
+func (wp *WorkerPool) Resize(n int) {
+	n = max(n, 1)
+
+	wp.submitMu.RLock()
+	defer wp.submitMu.RUnlock()
+	if wp.closed {
+		return
+	}
+
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	wp.minWorkers, wp.maxWorkers = n, n
+	if wp.started {
+		wp.fill()
+	}
+	// будим простаивающие воркеры, чтобы лишние завершились
+	close(wp.resized)
+	wp.resized = make(chan struct{})
+}
+
+// Workers возвращает текущее число воркеров
+func (wp *WorkerPool) Workers() int {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	return wp.workers
+}
+
+// grow запускает еще один воркер, если не достигнут максимум
+func (wp *WorkerPool) grow() {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	if wp.workers < wp.maxWorkers {
+		wp.spawn()
+	}
+}
+
+// fill и spawn вызываются под wp.mu
+func (wp *WorkerPool) fill() {
+	for wp.workers < wp.minWorkers {
+		wp.spawn()
+	}
+}
+
+func (wp *WorkerPool) spawn() {
+	wp.workers++
+	wp.wg.Add(1)
+	go wp.worker()
+}
+
+// retire уменьшает число воркеров и возвращает true, если вызвавший воркер должен завершиться:
+// воркеров больше максимума или воркер простаивает сверх минимума
+func (wp *WorkerPool) retire(idle bool) bool {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	if wp.workers > wp.maxWorkers || (idle && wp.workers > wp.minWorkers && len(wp.tasksChan) == 0) {
+		wp.workers--
+		return true
+	}
+	return false
+}
+
+// idleState возвращает канал, закрываемый при Resize, и таймаут простоя, если воркер может быть остановлен
+func (wp *WorkerPool) idleState() (<-chan struct{}, time.Duration) {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	if wp.minWorkers < wp.maxWorkers {
+		return wp.resized, wp.idleTimeout
+	}
+	return wp.resized, 0
+}
@@ -0,0 +1,115 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitWorkers ждет, пока число воркеров станет равным expected
+func waitWorkers(t *testing.T, wp *WorkerPool, expected int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for wp.Workers() != expected {
+		if time.Now().After(deadline) {
+			t.Fatalf("Workers() = %d, expected %d", wp.Workers(), expected)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestScaleUpAndDown(t *testing.T) {
+	wp := NewWorkerPool(1, 0,
+		WithMaxWorkers(4),
+		WithQueueSize(8),
+		WithIdleTimeout(10*time.Millisecond),
+	)
+	defer wp.Stop()
+	ctx := context.Background()
+
+	release := make(chan struct{})
+	futures := make([]*Future, 8)
+	for i := range futures {
+		future, err := wp.Submit(ctx, func() error {
+			<-release
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Submit() error = %v", err)
+		}
+		futures[i] = future
+	}
+	waitWorkers(t, wp, 4)
+
+	close(release)
+	for _, future := range futures {
+		if err := future.Wait(ctx); err != nil {
+			t.Errorf("Wait() error = %v", err)
+		}
+	}
+	waitWorkers(t, wp, 1)
+}
+
+func TestResize(t *testing.T) {
+	wp := NewWorkerPool(2, 0)
+	defer wp.Stop()
+	ctx := context.Background()
+
+	if _, err := wp.Submit(ctx, func() error { return nil }); err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	waitWorkers(t, wp, 2)
+
+	wp.Resize(5)
+	waitWorkers(t, wp, 5)
+
+	wp.Resize(1)
+	waitWorkers(t, wp, 1)
+
+	future, err := wp.Submit(ctx, func() error { return nil })
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	if err := future.Wait(ctx); err != nil {
+		t.Errorf("Wait() error = %v", err)
+	}
+}
+
+func TestResizeAfterShutdown(t *testing.T) {
+	wp := NewWorkerPool(2, 0)
+	if err := wp.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	wp.Resize(4)
+	if wp.Workers() != 0 {
+		t.Errorf("Workers() = %d, expected 0", wp.Workers())
+	}
+}
+
+// TestErrorLimitWhileScaling проверяет, что остановка пула по лимиту ошибок не блокируется
+// Submit, который в это же время добавляет воркер
+func TestErrorLimitWhileScaling(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		errTask := errors.New("task error")
+		for i := 0; i < 50; i++ {
+			tasks := make([]Task, 50)
+			for j := range tasks {
+				tasks[j] = func() error { return errTask }
+			}
+			wp := NewWorkerPool(1, 1, WithMaxWorkers(4), WithQueueSize(4))
+			if err := wp.Start(tasks); !errors.Is(err, ErrErrorsLimitExceeded) {
+				t.Errorf("Start() error = %v, expected %v", err, ErrErrorsLimitExceeded)
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Start() deadlocked")
+	}
+}
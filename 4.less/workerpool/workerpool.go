@@ -1,104 +1,429 @@
 package workerpool
 
 import (
+	"context"
 	"errors"
 	"sync"
+	"time"
 )
 
-var ErrErrorsLimitExceeded = errors.New("errors limit exceeded")
+var (
+	ErrErrorsLimitExceeded = errors.New("errors limit exceeded")
+	ErrPoolClosed          = errors.New("worker pool is closed")
+	ErrPoolStopped         = errors.New("worker pool is stopped")
+)
 
 type Task func() error
 
+// TaskCtx - задача, которая получает контекст. Контекст отменяется при остановке пула
+// (в том числе после превышения лимита ошибок), при отмене родительского контекста пула
+// и по истечении таймаута задачи
+type TaskCtx func(ctx context.Context) error
+
+func (t Task) withContext() TaskCtx {
+	return func(context.Context) error {
+		return t()
+	}
+}
+
+type job struct {
+	task    TaskCtx
+	future  *Future
+	timeout time.Duration
+	retry   *RetryPolicy
+}
+
+type TaskOption func(*job)
+
+// WithTimeout ограничивает время выполнения задачи, переопределяя WithTaskTimeout пула
+func WithTimeout(timeout time.Duration) TaskOption {
+	return func(j *job) {
+		j.timeout = timeout
+	}
+}
+
 type WorkerPool struct {
 	wg             *sync.WaitGroup
 	mu             *sync.Mutex
+	submitMu       *sync.RWMutex
+	submitWg       *sync.WaitGroup
 	closeOnce      *sync.Once
-	tasksChan      chan Task
+	tasksChan      chan job
 	doneChan       chan struct{}
+	closingChan    chan struct{}
+	shutdownChan   chan struct{}
+	parentCtx      context.Context
+	ctx            context.Context
+	cancel         context.CancelFunc
+	taskTimeout    time.Duration
+	retry          RetryPolicy
+	closed         bool
+	started        bool
+	resized        chan struct{}
 	errorCount     int
 	maxCountErrors int
 	tasksCount     int
-	workerCount    int
+	workers        int
+	minWorkers     int
+	maxWorkers     int
+	idleTimeout    time.Duration
+	queueSize      int
+}
+
+type Option func(*WorkerPool)
+
+// WithQueueSize задает размер очереди задач, ожидающих свободного воркера. По умолчанию очереди нет
+// и Submit ждет, пока задачу заберет воркер
+func WithQueueSize(size int) Option {
+	return func(wp *WorkerPool) {
+		wp.queueSize = size
+	}
 }
 
-func NewWorkerPool(n, m int) *WorkerPool {
-	return &WorkerPool{
+// WithContext задает родительский контекст пула: при его отмене пул останавливается через Stop,
+// а контексты выполняющихся задач отменяются
+func WithContext(ctx context.Context) Option {
+	return func(wp *WorkerPool) {
+		wp.parentCtx = ctx
+	}
+}
+
+// WithTaskTimeout задает таймаут для каждой задачи пула
+func WithTaskTimeout(timeout time.Duration) Option {
+	return func(wp *WorkerPool) {
+		wp.taskTimeout = timeout
+	}
+}
+
+// NewWorkerPool создает пул из n воркеров, который останавливается после m ошибок задач.
+// При m <= 0 ошибки не ограничиваются. Число воркеров можно сделать динамическим через
+// WithMinWorkers и WithMaxWorkers
+func NewWorkerPool(n, m int, options ...Option) *WorkerPool {
+	wp := &WorkerPool{
 		wg:             &sync.WaitGroup{},
 		mu:             &sync.Mutex{},
+		submitMu:       &sync.RWMutex{},
+		submitWg:       &sync.WaitGroup{},
 		closeOnce:      &sync.Once{},
-		tasksChan:      make(chan Task),
 		doneChan:       make(chan struct{}),
+		closingChan:    make(chan struct{}),
+		shutdownChan:   make(chan struct{}),
+		resized:        make(chan struct{}),
+		parentCtx:      context.Background(),
 		maxCountErrors: m,
-		workerCount:    n,
+		minWorkers:     n,
+		maxWorkers:     n,
+		idleTimeout:    defaultIdleTimeout,
+	}
+	for _, option := range options {
+		option(wp)
+	}
+	// хотя бы один воркер должен оставаться, иначе Submit без очереди некому принять
+	wp.minWorkers = max(wp.minWorkers, 1)
+	wp.maxWorkers = max(wp.maxWorkers, wp.minWorkers)
+	wp.tasksChan = make(chan job, max(wp.queueSize, 0))
+	wp.ctx, wp.cancel = context.WithCancel(wp.parentCtx)
+	context.AfterFunc(wp.ctx, wp.Stop)
+	return wp
+}
+
+func (wp *WorkerPool) startWorkers() {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	if !wp.started {
+		wp.started = true
+		wp.fill()
 	}
 }
 
 func (wp *WorkerPool) worker() {
 	defer wp.wg.Done()
+	retired := false
+	defer func() {
+		if !retired {
+			wp.mu.Lock()
+			wp.workers--
+			wp.mu.Unlock()
+		}
+	}()
+
 	for {
+		if wp.retire(false) {
+			retired = true
+			return
+		}
+
 		select {
 		case <-wp.doneChan:
 			return
 		default:
-			select {
-			case task, ok := <-wp.tasksChan:
-				if !ok {
-					return
-				}
-				err := task()
-				wp.mu.Lock()
-				if err != nil {
-					wp.errorCount++
-					if wp.errorCount >= wp.maxCountErrors {
-						wp.Stop()
-						wp.mu.Unlock()
-						return
-					}
-				}
-				wp.tasksCount++
-				wp.mu.Unlock()
+		}
 
+		resized, idleTimeout := wp.idleState()
+		var idle <-chan time.Time
+		var timer *time.Timer
+		if idleTimeout > 0 {
+			timer = time.NewTimer(idleTimeout)
+			idle = timer.C
+		}
+
+		select {
+		case j, ok := <-wp.tasksChan:
+			if !ok || !wp.execute(j) {
+				return
+			}
+		case <-wp.doneChan:
+			return
+		case <-resized:
+		case <-idle:
+			if wp.retire(true) {
+				retired = true
+				return
 			}
 		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// execute выполняет задачу и возвращает false, если после нее пул остановлен
+func (wp *WorkerPool) execute(j job) bool {
+	select {
+	case <-wp.doneChan:
+		j.future.skip()
+		return false
+	case <-wp.ctx.Done():
+		// родительский контекст отменен, Stop будет вызван через AfterFunc
+		j.future.skip()
+		return false
+	default:
+	}
+
+	err := wp.run(j)
+	j.future.complete(err)
+
+	// Stop берет submitMu, поэтому вызывается после снятия wp.mu: порядок блокировок всегда submitMu, затем wp.mu
+	if wp.countResult(err) {
+		wp.Stop()
+		return false
+	}
+	return true
+}
+
+// countResult учитывает результат задачи и возвращает true, если превышен лимит ошибок
+func (wp *WorkerPool) countResult(err error) bool {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	if err != nil {
+		wp.errorCount++
+		if wp.limitExceeded() {
+			return true
+		}
+	}
+	wp.tasksCount++
+	return false
+}
+
+// run выполняет задачу с повторами по ее политике. Таймаут действует на каждую попытку отдельно
+func (wp *WorkerPool) run(j job) error {
+	timeout := wp.taskTimeout
+	if j.timeout > 0 {
+		timeout = j.timeout
+	}
+	policy := wp.retry
+	if j.retry != nil {
+		policy = *j.retry
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := wp.attempt(j.task, timeout)
+		if err == nil || !policy.shouldRetry(attempt, err) || !sleep(wp.ctx, policy.backoff(attempt)) {
+			return err
+		}
+	}
+}
+
+func (wp *WorkerPool) attempt(task TaskCtx, timeout time.Duration) error {
+	ctx := wp.ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return task(ctx)
+}
+
+// limitExceeded вызывается под wp.mu
+func (wp *WorkerPool) limitExceeded() bool {
+	return wp.maxCountErrors > 0 && wp.errorCount >= wp.maxCountErrors
+}
+
+// Submit ставит задачу в очередь пула и возвращает Future для ожидания ее результата.
+// Воркеры запускаются при первом вызове. ctx ограничивает только ожидание места в очереди
+func (wp *WorkerPool) Submit(ctx context.Context, task Task, options ...TaskOption) (*Future, error) {
+	return wp.SubmitCtx(ctx, task.withContext(), options...)
+}
+
+// SubmitCtx работает как Submit, но принимает задачу с контекстом
+func (wp *WorkerPool) SubmitCtx(ctx context.Context, task TaskCtx, options ...TaskOption) (*Future, error) {
+	if err := wp.beginSubmit(); err != nil {
+		return nil, err
+	}
+	defer wp.submitWg.Done()
+
+	wp.startWorkers()
+	j := job{task: task, future: newFuture()}
+	for _, option := range options {
+		option(&j)
+	}
+
+	// задачу сразу забрал воркер или она встала в очередь; если очередь растет, добавляем воркер
+	select {
+	case wp.tasksChan <- j:
+		if len(wp.tasksChan) > 0 {
+			wp.grow()
+		}
+		return j.future, nil
+	default:
+	}
+
+	// свободных воркеров и места в очереди нет
+	wp.grow()
+	select {
+	case wp.tasksChan <- j:
+		return j.future, nil
+	case <-wp.closingChan:
+		return nil, ErrPoolClosed
+	case <-wp.doneChan:
+		return nil, ErrPoolStopped
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// beginSubmit регистрирует Submit в submitWg. Shutdown и Stop сначала берут submitMu.Lock, поэтому
+// после них новые Submit не регистрируются, а уже начатые дожидаются через submitWg
+func (wp *WorkerPool) beginSubmit() error {
+	wp.submitMu.RLock()
+	defer wp.submitMu.RUnlock()
+
+	if wp.closed {
+		return ErrPoolClosed
+	}
+	select {
+	case <-wp.doneChan:
+		return ErrPoolStopped
+	default:
+	}
+	wp.submitWg.Add(1)
+	return nil
+}
+
+// Shutdown перестает принимать новые задачи и ждет, пока воркеры выполнят уже поставленные в очередь.
+// Если ctx завершится раньше, пул останавливается через Stop: задачи из очереди не выполняются,
+// а их Future получают ErrPoolStopped
+func (wp *WorkerPool) Shutdown(ctx context.Context) error {
+	wp.submitMu.Lock()
+	if !wp.closed {
+		wp.closed = true
+		// Submit, ожидающие места в очереди, выходят по closingChan; tasksChan закрывается,
+		// только когда в него больше никто не пишет
+		close(wp.closingChan)
+		go func() {
+			wp.submitWg.Wait()
+			close(wp.tasksChan)
+			wp.wg.Wait()
+			close(wp.shutdownChan)
+		}()
+	}
+	wp.submitMu.Unlock()
+
+	select {
+	case <-wp.shutdownChan:
+		return nil
+	case <-ctx.Done():
+		wp.Stop()
+		return ctx.Err()
 	}
 }
 
 func (wp *WorkerPool) Start(tasks []Task) error {
-	for i := 0; i < wp.workerCount; i++ {
-		wp.wg.Add(1)
-		go wp.worker()
+	tasksCtx := make([]TaskCtx, len(tasks))
+	for i, task := range tasks {
+		tasksCtx[i] = task.withContext()
 	}
+	return wp.StartCtx(tasksCtx)
+}
 
-	go func() {
-		defer close(wp.tasksChan)
-		for _, task := range tasks {
-			select {
-			case <-wp.doneChan:
-				return
-			default:
-				select {
-				case wp.tasksChan <- task:
-				}
-			}
+// StartCtx выполняет задачи с контекстом и останавливает пул. Если не все задачи выполнены успешно,
+// возвращается *MultiError с ошибками задач; причину досрочной остановки (ErrErrorsLimitExceeded
+// или ошибку родительского контекста) можно проверить через errors.Is
+func (wp *WorkerPool) StartCtx(tasks []TaskCtx) error {
+	futures := make([]*Future, 0, len(tasks))
+	for _, task := range tasks {
+		future, err := wp.SubmitCtx(wp.ctx, task)
+		if err != nil {
+			break
 		}
-	}()
+		futures = append(futures, future)
+	}
 
-	wp.wg.Wait()
+	_ = wp.Shutdown(context.Background())
 	wp.Stop()
 
+	result := &MultiError{Skipped: len(tasks) - len(futures)}
+	for i, future := range futures {
+		<-future.Done()
+		switch {
+		case future.skipped:
+			result.Skipped++
+		case future.err != nil:
+			result.Failed++
+			result.Errors = append(result.Errors, &TaskError{Index: i, Err: future.err})
+		default:
+			result.Succeeded++
+		}
+	}
+
 	wp.mu.Lock()
-	defer wp.mu.Unlock()
-	if wp.errorCount >= wp.maxCountErrors {
-		return ErrErrorsLimitExceeded
+	if wp.limitExceeded() {
+		result.Cause = ErrErrorsLimitExceeded
+	} else {
+		result.Cause = context.Cause(wp.parentCtx)
 	}
+	wp.mu.Unlock()
 
-	return nil
+	if result.Cause == nil && result.Failed == 0 && result.Skipped == 0 {
+		return nil
+	}
+	return result
 }
 
+// Stop прерывает работу пула: воркеры завершаются после текущих задач, а задачи из очереди
+// не выполняются и их Future получают ErrPoolStopped
 func (wp *WorkerPool) Stop() {
 	wp.closeOnce.Do(
 		func() {
 			close(wp.doneChan)
+			wp.cancel()
+
+			// после submitMu.Lock новые Submit не начинаются, а начатые выходят по doneChan;
+			// когда они завершатся, очередь можно разобрать
+			wp.submitMu.Lock()
+			wp.submitMu.Unlock()
+			wp.submitWg.Wait()
+			for {
+				select {
+				case j, ok := <-wp.tasksChan:
+					if !ok {
+						return
+					}
+					j.future.skip()
+				default:
+					return
+				}
+			}
 		},
 	)
 }
@@ -111,3 +436,14 @@ func Run(tasks []Task, n, m int) error {
 	wp := NewWorkerPool(n, m)
 	return wp.Start(tasks)
 }
+
+// RunContext работает как Run, но задачи получают контекст, который отменяется при отмене ctx
+// или после m ошибок
+func RunContext(ctx context.Context, tasks []TaskCtx, n, m int) error {
+	if m <= 0 {
+		return ErrErrorsLimitExceeded
+	}
+
+	wp := NewWorkerPool(n, m, WithContext(ctx))
+	return wp.StartCtx(tasks)
+}
@@ -1,7 +1,9 @@
 package workerpool
 
 import (
+	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -60,10 +62,272 @@ func TestRun(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				err := Run(tt.tasks, tt.n, tt.m)
-				if err != tt.expectedError {
+				if !errors.Is(err, tt.expectedError) {
 					t.Errorf("Run() error = %v, expectedError %v", err, tt.expectedError)
 				}
 			},
 		)
 	}
 }
+
+func TestSubmitFuture(t *testing.T) {
+	wp := NewWorkerPool(2, 0, WithQueueSize(4))
+	ctx := context.Background()
+	errTask := errors.New("task error")
+
+	ok, err := wp.Submit(ctx, func() error { return nil })
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	failed, err := wp.Submit(ctx, func() error { return errTask })
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	if err := ok.Wait(ctx); err != nil {
+		t.Errorf("Wait() error = %v, expected nil", err)
+	}
+	if err := failed.Wait(ctx); !errors.Is(err, errTask) {
+		t.Errorf("Wait() error = %v, expected %v", err, errTask)
+	}
+	if err := wp.Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+}
+
+func TestShutdownDrainsQueue(t *testing.T) {
+	wp := NewWorkerPool(1, 0, WithQueueSize(10))
+	ctx := context.Background()
+	release := make(chan struct{})
+	var executed atomic.Int32
+
+	futures := make([]*Future, 5)
+	for i := range futures {
+		future, err := wp.Submit(ctx, func() error {
+			<-release
+			executed.Add(1)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Submit() error = %v", err)
+		}
+		futures[i] = future
+	}
+
+	shutdownErr := make(chan error, 1)
+	go func() { shutdownErr <- wp.Shutdown(ctx) }()
+	close(release)
+
+	if err := <-shutdownErr; err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if executed.Load() != int32(len(futures)) {
+		t.Errorf("executed %d tasks, expected %d", executed.Load(), len(futures))
+	}
+	for _, future := range futures {
+		if err := future.Wait(ctx); err != nil {
+			t.Errorf("Wait() error = %v", err)
+		}
+	}
+	if _, err := wp.Submit(ctx, func() error { return nil }); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Submit() after Shutdown error = %v, expected %v", err, ErrPoolClosed)
+	}
+}
+
+func TestShutdownContextStopsQueuedTasks(t *testing.T) {
+	wp := NewWorkerPool(1, 0, WithQueueSize(1))
+	release := make(chan struct{})
+	defer close(release)
+
+	running, _ := wp.Submit(context.Background(), func() error {
+		<-release
+		return nil
+	})
+	queued, _ := wp.Submit(context.Background(), func() error { return nil })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := wp.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Shutdown() error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+	if err := queued.Wait(context.Background()); !errors.Is(err, ErrPoolStopped) {
+		t.Errorf("queued task Wait() error = %v, expected %v", err, ErrPoolStopped)
+	}
+
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer waitCancel()
+	if err := running.Wait(waitCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("running task Wait() error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRunContextCancelsOnErrorLimit(t *testing.T) {
+	errTask := errors.New("task error")
+	started := make(chan struct{})
+	tasks := []TaskCtx{
+		func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		func(ctx context.Context) error {
+			<-started
+			return errTask
+		},
+	}
+
+	err := RunContext(context.Background(), tasks, 2, 1)
+	if !errors.Is(err, ErrErrorsLimitExceeded) {
+		t.Errorf("RunContext() error = %v, expected %v", err, ErrErrorsLimitExceeded)
+	}
+}
+
+func TestRunContextParentCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var executed atomic.Int32
+	tasks := make([]TaskCtx, 10)
+	for i := range tasks {
+		tasks[i] = func(ctx context.Context) error {
+			executed.Add(1)
+			cancel()
+			<-ctx.Done()
+			return nil
+		}
+	}
+
+	err := RunContext(ctx, tasks, 2, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("RunContext() error = %v, expected %v", err, context.Canceled)
+	}
+	if executed.Load() > 2 {
+		t.Errorf("executed %d tasks after cancel, expected at most 2", executed.Load())
+	}
+}
+
+func TestTaskTimeout(t *testing.T) {
+	wp := NewWorkerPool(1, 0, WithTaskTimeout(time.Hour))
+	defer wp.Stop()
+	ctx := context.Background()
+	wait := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	future, err := wp.SubmitCtx(ctx, wait, WithTimeout(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("SubmitCtx() error = %v", err)
+	}
+	if err := future.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestStopCancelsRunningTask(t *testing.T) {
+	wp := NewWorkerPool(1, 0)
+	started := make(chan struct{})
+	future, err := wp.SubmitCtx(context.Background(), func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatalf("SubmitCtx() error = %v", err)
+	}
+
+	<-started
+	wp.Stop()
+	if err := future.Wait(context.Background()); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait() error = %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestRunAggregatesErrors(t *testing.T) {
+	errFirst := errors.New("first")
+	errSecond := errors.New("second")
+	tasks := []Task{
+		func() error { return nil },
+		func() error { return errFirst },
+		func() error { return nil },
+		func() error { return errSecond },
+	}
+
+	err := Run(tasks, 1, 10)
+	var multiErr *MultiError
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("Run() error = %v, expected *MultiError", err)
+	}
+	if errors.Is(err, ErrErrorsLimitExceeded) {
+		t.Errorf("Run() error = %v, limit is not exceeded", err)
+	}
+	if !errors.Is(err, errFirst) || !errors.Is(err, errSecond) {
+		t.Errorf("Run() error = %v, expected to wrap task errors", err)
+	}
+	if multiErr.Succeeded != 2 || multiErr.Failed != 2 || multiErr.Skipped != 0 {
+		t.Errorf("counts = %d/%d/%d, expected 2/2/0", multiErr.Succeeded, multiErr.Failed, multiErr.Skipped)
+	}
+	if multiErr.Errors[0].Index != 1 || multiErr.Errors[1].Index != 3 {
+		t.Errorf("indices = %d, %d, expected 1, 3", multiErr.Errors[0].Index, multiErr.Errors[1].Index)
+	}
+}
+
+func TestRunErrorLimitCounts(t *testing.T) {
+	errTask := errors.New("task error")
+	tasks := make([]Task, 10)
+	for i := range tasks {
+		tasks[i] = func() error { return errTask }
+	}
+
+	err := Run(tasks, 1, 2)
+	if !errors.Is(err, ErrErrorsLimitExceeded) {
+		t.Fatalf("Run() error = %v, expected %v", err, ErrErrorsLimitExceeded)
+	}
+	var multiErr *MultiError
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("Run() error = %v, expected *MultiError", err)
+	}
+	if multiErr.Failed != 2 || multiErr.Skipped != len(tasks)-2 {
+		t.Errorf("failed = %d, skipped = %d, expected 2 and %d", multiErr.Failed, multiErr.Skipped, len(tasks)-2)
+	}
+}
+
+func TestShutdownWithPendingSubmit(t *testing.T) {
+	wp := NewWorkerPool(1, 0)
+	release := make(chan struct{})
+	defer close(release)
+
+	started := make(chan struct{})
+	if _, err := wp.Submit(context.Background(), func() error {
+		close(started)
+		<-release
+		return nil
+	}); err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	<-started
+
+	// воркер занят, поэтому Submit ждет свободного места
+	submitErr := make(chan error, 1)
+	go func() {
+		_, err := wp.Submit(context.Background(), func() error { return nil })
+		submitErr <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	shutdownErr := make(chan error, 1)
+	go func() { shutdownErr <- wp.Shutdown(ctx) }()
+
+	select {
+	case err := <-shutdownErr:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Shutdown() error = %v, expected %v", err, context.DeadlineExceeded)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Shutdown() is blocked by pending Submit")
+	}
+	if err := <-submitErr; !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("pending Submit() error = %v, expected %v", err, ErrPoolClosed)
+	}
+}